@@ -7,6 +7,7 @@ import testPlanRoutes from './routes/test_plans.js';
 import mappingRoutes from './routes/mappings.js';
 import credentialRoutes from './routes/credentials.js';
 import testExecutionResultRoutes from './routes/test_execution_results.js';
+import versionRoutes from './routes/version.js';
 import { errorHandler } from './middleware/error_handler.js';
 
 // Create and configure the Express app
@@ -27,6 +28,7 @@ app.use('/api/v1/test-plans', testPlanRoutes);
 app.use('/api/v1/mappings', mappingRoutes);
 app.use('/api/v1/credentials', credentialRoutes);
 app.use('/api/v1/test-execution-results', testExecutionResultRoutes)
+app.use('/api/v1/version', versionRoutes);
 
 // Error Handling Middleware (must be last)
 app.use(errorHandler);
@@ -25,6 +25,34 @@ export class CredentialService implements ICredentialService {
     return this.secureCredentialService.addCredentials(profileName, credentials);
   }
 
+  /**
+   * Merges the changes into the stored credentials and saves them in a single write,
+   * so the profile is never missing while it is being updated.
+   */
+  public async update(profileName: string, changes: Partial<IntegrationPlatformCredentials>): Promise<CredentialProfile | null> {
+    const existing = await this.secureCredentialService.getCredentials(profileName);
+    if (!existing) {
+      return null;
+    }
+
+    const updated: IntegrationPlatformCredentials = {
+      accountId: changes.accountId ?? existing.accountId,
+      username: changes.username ?? existing.username,
+      passwordOrToken: changes.passwordOrToken ?? existing.passwordOrToken,
+      executionInstanceId: changes.executionInstanceId ?? existing.executionInstanceId,
+    };
+    await this.secureCredentialService.addCredentials(profileName, updated);
+
+    return {
+      profileName,
+      credentials: {
+        accountId: updated.accountId,
+        username: updated.username,
+        executionInstanceId: updated.executionInstanceId,
+      },
+    };
+  }
+
   /**
    * Delegates the listing of all profiles to the underlying secure storage adapter.
    */
@@ -66,6 +66,40 @@ describe('CredentialService', () => {
         });
     });
 
+    describe('update', () => {
+        const stored: IntegrationPlatformCredentials = {
+            accountId: 'acc-123',
+            username: 'user',
+            passwordOrToken: 'old-secret',
+            executionInstanceId: 'atom-456',
+        };
+
+        it('should merge the changes into the stored credentials and save them in one write', async () => {
+            mockSecureCredentialService.getCredentials.mockResolvedValue({ ...stored });
+
+            const result = await service.update('test-profile', { passwordOrToken: 'new-secret' });
+
+            expect(mockSecureCredentialService.addCredentials).toHaveBeenCalledTimes(1);
+            expect(mockSecureCredentialService.addCredentials).toHaveBeenCalledWith('test-profile', {
+                ...stored,
+                passwordOrToken: 'new-secret',
+            });
+            expect(result).toEqual({
+                profileName: 'test-profile',
+                credentials: { accountId: 'acc-123', username: 'user', executionInstanceId: 'atom-456' },
+            });
+        });
+
+        it('should return null and not write anything when the profile does not exist', async () => {
+            mockSecureCredentialService.getCredentials.mockResolvedValue(null);
+
+            const result = await service.update('missing-profile', { executionInstanceId: 'atom-789' });
+
+            expect(result).toBeNull();
+            expect(mockSecureCredentialService.addCredentials).not.toHaveBeenCalled();
+        });
+    });
+
     describe('delete', () => {
         it('should delegate the call to the secure credential service deleteCredentials method and return the result', async () => {
             const profileName = 'profile-to-delete';
@@ -205,7 +205,10 @@ export class TestPlanService implements ITestPlanService {
         const testPlan = await this.testPlanRepository.findById(planId);
         if (!testPlan) return null;
 
-        const planComponents = await this.planComponentRepository.findByTestPlanId(planId);
+        const [planComponents, entryPoints] = await Promise.all([
+            this.planComponentRepository.findByTestPlanId(planId),
+            this.testPlanEntryPointRepository.findByTestPlanId(planId),
+        ]);
         const planComponentIds = planComponents.map(c => c.id);
         const mainComponentIds = planComponents.map(c => c.componentId);
 
@@ -228,7 +231,7 @@ export class TestPlanService implements ITestPlanService {
             executionResults: resultsByComponentId.get(component.id) || [],
         }));
 
-        return { ...testPlan, planComponents: planComponentsDetails };
+        return { ...testPlan, entryPoints, planComponents: planComponentsDetails };
     }
 
     public async deletePlan(planId: string): Promise<void> {
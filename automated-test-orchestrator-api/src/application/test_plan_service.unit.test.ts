@@ -24,6 +24,7 @@ const mockTestPlanRepo: jest.Mocked<ITestPlanRepository> = {
 
 const mockTestPlanEntryPointRepo: jest.Mocked<ITestPlanEntryPointRepository> = {
     saveAll: jest.fn(),
+    findByTestPlanId: jest.fn(),
 };
 
 const mockPlanComponentRepo: jest.Mocked<IPlanComponentRepository> = {
@@ -126,6 +127,7 @@ describe('TestPlanService', () => {
 
             mockTestPlanRepo.findById.mockResolvedValue(mockPlan);
             mockPlanComponentRepo.findByTestPlanId.mockResolvedValue(mockComponents);
+            mockTestPlanEntryPointRepo.findByTestPlanId.mockResolvedValue([{ id: 'ep-1', testPlanId: planId, componentId: 'comp-A' }]);
             mockTestExecutionResultRepo.findByPlanComponentIds.mockResolvedValue(mockResults);
             mockMappingRepo.findAllTestsForMainComponents.mockResolvedValue(mockMappings);
 
@@ -140,6 +142,27 @@ describe('TestPlanService', () => {
             expect(result.planComponents[1].availableTests).toEqual([{ id: 'test-B', name: 'Test B' }]);
             expect(result.planComponents[1].executionResults).toHaveLength(0);
         });
+
+        it('should include the plan\'s entry points, which mark the components given as input', async () => {
+            const planId = 'plan-entry';
+            const mockPlan: TestPlan = { id: planId, name: 'Entry Plan', planType: TestPlanType.COMPONENT, status: TestPlanStatus.COMPLETED, createdAt: new Date(), updatedAt: new Date() };
+            // In COMPONENT mode every component, inputs included, is saved as DISCOVERED.
+            const mockComponents: PlanComponent[] = [
+                { id: 'pc-1', testPlanId: planId, componentId: 'comp-A', sourceType: 'DISCOVERED' },
+                { id: 'pc-2', testPlanId: planId, componentId: 'comp-B', sourceType: 'DISCOVERED' },
+            ];
+
+            mockTestPlanRepo.findById.mockResolvedValue(mockPlan);
+            mockPlanComponentRepo.findByTestPlanId.mockResolvedValue(mockComponents);
+            mockTestPlanEntryPointRepo.findByTestPlanId.mockResolvedValue([{ id: 'ep-1', testPlanId: planId, componentId: 'comp-A' }]);
+            mockTestExecutionResultRepo.findByPlanComponentIds.mockResolvedValue([]);
+            mockMappingRepo.findAllTestsForMainComponents.mockResolvedValue(new Map());
+
+            const result = await service.getPlanWithDetails(planId) as TestPlanWithDetails;
+
+            expect(mockTestPlanEntryPointRepo.findByTestPlanId).toHaveBeenCalledWith(planId);
+            expect(result.entryPoints).toEqual([{ id: 'ep-1', testPlanId: planId, componentId: 'comp-A' }]);
+        });
     });
 
     describe('initiateDiscovery', () => {
@@ -55,6 +55,36 @@ describe('Credentials API End-to-End Test', () => {
         expect(deletedProfile).toBeUndefined();
     });
 
+    it('should update individual fields of a profile with PATCH', async () => {
+        await request(app).post('/api/v1/credentials').send({
+            profileName: testProfileName,
+            accountId: 'e2e-creds-account',
+            username: 'e2e-creds-user',
+            passwordOrToken: 'e2e-creds-pass',
+            executionInstanceId: 'e2e-creds-atom'
+        }).expect(201);
+
+        const response = await request(app)
+            .patch(`/api/v1/credentials/${testProfileName}`)
+            .send({ passwordOrToken: 'e2e-rotated-pass', executionInstanceId: 'e2e-creds-atom-2' })
+            .expect(200);
+
+        expect(response.body.data).toEqual({
+            profileName: testProfileName,
+            credentials: { accountId: 'e2e-creds-account', username: 'e2e-creds-user', executionInstanceId: 'e2e-creds-atom-2' },
+        });
+        expect(response.body.data.credentials.passwordOrToken).toBeUndefined();
+
+        await request(app).patch(`/api/v1/credentials/${testProfileName}`).send({}).expect(400);
+        await request(app).patch(`/api/v1/credentials/${testProfileName}`).send({ username: '' }).expect(400);
+
+        await request(app).delete(`/api/v1/credentials/${testProfileName}`).expect(204);
+    });
+
+    it('should return a 404 when trying to update a profile that does not exist', async () => {
+        await request(app).patch('/api/v1/credentials/non-existent-profile-e2e').send({ username: 'x' }).expect(404);
+    });
+
     it('should return a 404 when trying to delete a profile that does not exist', async () => {
         await request(app).delete('/api/v1/credentials/non-existent-profile-e2e').expect(404);
     });
@@ -0,0 +1,19 @@
+// src/e2e/version.e2e.test.ts
+
+import request from 'supertest';
+import app from '../app.js';
+import { API_VERSION } from '../routes/version.js';
+
+describe('Version API End-to-End Test', () => {
+    it('should return the server version and the API contract version', async () => {
+        const response = await request(app)
+            .get('/api/v1/version')
+            .expect(200);
+
+        expect(response.body.metadata.code).toBe(200);
+        expect(response.body.data.apiVersion).toBe(API_VERSION);
+        expect(response.body.data.apiVersion).toBe('v1');
+        expect(typeof response.body.data.version).toBe('string');
+        expect(response.body.data.version).not.toBe('');
+    });
+});
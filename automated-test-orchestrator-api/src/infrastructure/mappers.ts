@@ -4,6 +4,7 @@ import { TestPlan } from '../domain/test_plan.js';
 import { PlanComponent } from '../domain/plan_component.js';
 import { Mapping } from '../domain/mapping.js';
 import { TestExecutionResult } from '../domain/test_execution_result.js';
+import { TestPlanEntryPoint } from '../domain/test_plan_entry_point.js';
 
 /**
  * Maps a raw database row from the 'test_plans' table to a TestPlan domain object.
@@ -40,6 +41,20 @@ export function rowToPlanComponent(row: any): PlanComponent {
   };
 }
 
+/**
+ * Maps a raw database row from the 'test_plan_entry_points' table to a TestPlanEntryPoint domain object.
+ * @param row The raw row object from the database (snake_case).
+ * @returns A TestPlanEntryPoint object (camelCase).
+ */
+export function rowToTestPlanEntryPoint(row: any): TestPlanEntryPoint {
+  if (!row) return row;
+  return {
+    id: row.id,
+    testPlanId: row.test_plan_id,
+    componentId: row.component_id,
+  };
+}
+
 /**
  * Maps a raw database row from the 'mappings' table to a Mapping domain object.
  * @param row The raw row object from the database (snake_case).
@@ -81,4 +81,23 @@ describe('TestPlanEntryPointRepository Integration Tests', () => {
             expect(result.rowCount).toBe(0);
         });
     });
+
+    describe('findByTestPlanId', () => {
+        it('should return only the entry points of the given test plan', async () => {
+            // Arrange
+            await repository.saveAll([
+                { id: uuidv4(), testPlanId: parentTestPlan.id, componentId: 'comp-A' },
+                { id: uuidv4(), testPlanId: parentTestPlan.id, componentId: 'comp-B' },
+            ]);
+
+            // Act
+            const entryPoints = await repository.findByTestPlanId(parentTestPlan.id);
+            const none = await repository.findByTestPlanId(uuidv4());
+
+            // Assert
+            expect(entryPoints.map(e => e.componentId).sort()).toEqual(['comp-A', 'comp-B']);
+            expect(entryPoints[0].testPlanId).toBe(parentTestPlan.id);
+            expect(none).toEqual([]);
+        });
+    });
 });
\ No newline at end of file
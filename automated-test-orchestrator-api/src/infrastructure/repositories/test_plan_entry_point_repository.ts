@@ -4,6 +4,7 @@ import type { Pool } from 'pg';
 import { injectable, inject } from 'inversify';
 import { ITestPlanEntryPointRepository } from '../../ports/i_test_plan_entry_point_repository.js';
 import { TestPlanEntryPoint } from '../../domain/test_plan_entry_point.js';
+import { rowToTestPlanEntryPoint } from '../mappers.js';
 import { TYPES } from '../../inversify.types.js';
 
 @injectable()
@@ -30,4 +31,10 @@ export class TestPlanEntryPointRepository implements ITestPlanEntryPointReposito
             client.release();
         }
     }
+
+    async findByTestPlanId(testPlanId: string): Promise<TestPlanEntryPoint[]> {
+        const query = 'SELECT * FROM test_plan_entry_points WHERE test_plan_id = $1;';
+        const result = await this.pool.query(query, [testPlanId]);
+        return result.rows.map(rowToTestPlanEntryPoint);
+    }
 }
\ No newline at end of file
@@ -18,6 +18,17 @@ export interface ICredentialService {
    */
   add(profileName: string, credentials: IntegrationPlatformCredentials): Promise<void>;
 
+  /**
+   * Changes individual fields of an existing credential profile. Fields that are
+   * not supplied keep their stored values, so a token can be replaced without
+   * re-sending the rest of the profile.
+   *
+   * @param profileName The name of the profile to update.
+   * @param changes The credential fields to replace.
+   * @returns A promise that resolves with the updated profile in a display-safe format, or null if it does not exist.
+   */
+  update(profileName: string, changes: Partial<IntegrationPlatformCredentials>): Promise<CredentialProfile | null>;
+
   /**
    * Retrieves a list of all saved credential profiles in a display-safe format.
    *
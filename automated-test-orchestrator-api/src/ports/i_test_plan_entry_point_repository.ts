@@ -4,4 +4,5 @@ import { TestPlanEntryPoint } from '../domain/test_plan_entry_point.js';
 
 export interface ITestPlanEntryPointRepository {
   saveAll(entryPoints: TestPlanEntryPoint[]): Promise<void>;
+  findByTestPlanId(testPlanId: string): Promise<TestPlanEntryPoint[]>;
 }
\ No newline at end of file
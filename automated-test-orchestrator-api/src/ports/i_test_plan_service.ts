@@ -3,6 +3,7 @@
 import { TestPlan, TestPlanType } from "../domain/test_plan.js";
 import { PlanComponent } from '../domain/plan_component.js';
 import { TestExecutionResult } from "../domain/test_execution_result.js";
+import { TestPlanEntryPoint } from '../domain/test_plan_entry_point.js';
 import { AvailableTestInfo } from "./i_mapping_repository.js";
 
 export type PlanComponentDetails = PlanComponent & {
@@ -11,6 +12,7 @@ export type PlanComponentDetails = PlanComponent & {
 };
 
 export type TestPlanWithDetails = TestPlan & {
+  entryPoints: TestPlanEntryPoint[];
   planComponents: PlanComponentDetails[];
 };
 
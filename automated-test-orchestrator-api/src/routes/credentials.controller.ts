@@ -4,6 +4,7 @@ import { Request, Response } from 'express';
 import { injectable, inject } from 'inversify';
 import { TYPES } from '../inversify.types.js';
 import { ICredentialService } from '../ports/i_credential_service.js';
+import { IntegrationPlatformCredentials } from '../domain/integration_platform_credentials.js';
 import { BadRequestError, NotFoundError } from '../utils/app_error.js';
 
 /**
@@ -38,6 +39,13 @@ import { BadRequestError, NotFoundError } from '../utils/app_error.js';
  *             executionInstanceId:
  *               type: string
  *               example: "atom-1a2b3c"
+ *     ApiResponse_CredentialProfile:
+ *       type: object
+ *       properties:
+ *         metadata:
+ *           $ref: '#/components/schemas/ResponseMetadata'
+ *         data:
+ *           $ref: '#/components/schemas/CredentialProfile'
  *     ApiResponse_CredentialProfileList:
  *       type: object
  *       properties:
@@ -132,6 +140,84 @@ export class CredentialsController {
     });
   }
 
+  /**
+   * @swagger
+   * /credentials/{profileName}:
+   *   patch:
+   *     summary: Update a Credential Profile
+   *     tags: [Credentials]
+   *     description: Replaces individual fields of an existing credential profile, such as rotating the password or token. Fields that are omitted keep their current values. The profile is updated in a single write.
+   *     parameters:
+   *       - in: path
+   *         name: profileName
+   *         required: true
+   *         schema:
+   *           type: string
+   *           example: "dev-account"
+   *     requestBody:
+   *       required: true
+   *       content:
+   *         application/json:
+   *           schema:
+   *             type: object
+   *             minProperties: 1
+   *             properties:
+   *               accountId:
+   *                 type: string
+   *                 example: "boomi-V123XYZ"
+   *               username:
+   *                 type: string
+   *                 example: "user@example.com"
+   *               passwordOrToken:
+   *                 type: string
+   *                 format: password
+   *                 example: "a-new-secret-token-value"
+   *               executionInstanceId:
+   *                 type: string
+   *                 example: "atom-1a2b3c"
+   *     responses:
+   *       '200':
+   *         description: OK. The updated profile, omitting sensitive fields.
+   *         content:
+   *           application/json:
+   *             schema:
+   *               $ref: '#/components/schemas/ApiResponse_CredentialProfile'
+   *       '400':
+   *         description: Bad Request. No fields were supplied, or a field is empty.
+   *       '404':
+   *         description: Not Found. The specified profile does not exist.
+   */
+  public async updateCredential(req: Request, res: Response): Promise<void> {
+    const { profileName } = req.params;
+    const fields = ['accountId', 'username', 'passwordOrToken', 'executionInstanceId'] as const;
+
+    const changes: Partial<IntegrationPlatformCredentials> = {};
+    for (const field of fields) {
+      const value = req.body?.[field];
+      if (value === undefined) {
+        continue;
+      }
+      if (typeof value !== 'string' || value.trim() === '') {
+        throw new BadRequestError(`Field "${field}" must be a non-empty string.`);
+      }
+      changes[field] = value;
+    }
+
+    if (Object.keys(changes).length === 0) {
+      throw new BadRequestError(`Request body must include at least one of: ${fields.join(', ')}.`);
+    }
+
+    const profile = await this.credentialService.update(profileName, changes);
+    if (!profile) {
+      throw new NotFoundError(`Profile "${profileName}" not found.`);
+    }
+
+    res.status(200).json({
+      metadata: { code: 200, message: 'OK' },
+      data: profile,
+    });
+  }
+
   /**
    * @swagger
    * /credentials/{profileName}:
@@ -18,6 +18,7 @@ const credentialsController = container.get<CredentialsController>(TYPES.Credent
 
 router.post('/', asyncHandler(credentialsController.addCredential.bind(credentialsController)));
 router.get('/', asyncHandler(credentialsController.listCredentials.bind(credentialsController)));
+router.patch('/:profileName', asyncHandler(credentialsController.updateCredential.bind(credentialsController)));
 router.delete('/:profileName', asyncHandler(credentialsController.deleteCredential.bind(credentialsController)));
 
 export default router;
\ No newline at end of file
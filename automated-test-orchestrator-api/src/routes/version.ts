@@ -0,0 +1,43 @@
+// src/routes/version.ts
+
+import { Router, Request, Response } from 'express';
+import fs from 'fs';
+import path from 'path';
+
+// The version of the REST contract under /api/v1. Clients compare it with the one they speak.
+export const API_VERSION = 'v1';
+
+// The server version is read from package.json, which sits in the app's root directory
+// both locally and in the container.
+const readServerVersion = (): string => {
+  try {
+    const packagePath = path.resolve(process.cwd(), './package.json');
+    return JSON.parse(fs.readFileSync(packagePath).toString()).version ?? 'unknown';
+  } catch {
+    return 'unknown';
+  }
+};
+
+const serverVersion = readServerVersion();
+
+const router = Router();
+
+/**
+ * @swagger
+ * /version:
+ *   get:
+ *     summary: Get the Server Version
+ *     tags: [Version]
+ *     description: Reports the server version and the API contract version, so clients can check that they are compatible.
+ *     responses:
+ *       '200':
+ *         description: OK. The server and API versions.
+ */
+router.get('/', (req: Request, res: Response) => {
+  res.status(200).json({
+    metadata: { code: 200, message: 'OK' },
+    data: { version: serverVersion, apiVersion: API_VERSION },
+  });
+});
+
+export default router;
@@ -17,7 +17,7 @@ const options: swaggerJsdoc.Options = {
       }
     ]
   },
-  apis: ['./dist/routes/*.controller.js'],
+  apis: ['./dist/routes/*.controller.js', './dist/routes/version.js'],
 };
 
 const swaggerSpec = swaggerJsdoc(options);
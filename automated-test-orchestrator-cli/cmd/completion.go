@@ -0,0 +1,166 @@
+// automated-test-orchestrator-cli/cmd/completion.go
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/client"
+	"github.com/automated-test-orchestrator/cli-go/internal/export"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// Shell completion runs a fresh ato process for every Tab press, so API-backed
+// suggestions are cached on disk for a short while to keep completion snappy.
+const (
+	completionCacheTTL     = 30 * time.Second
+	completionFetchTimeout = 3 * time.Second
+)
+
+// completionFunc is the signature cobra uses for argument and flag completion.
+type completionFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// completePlanIDs completes plan IDs, described by plan name and status.
+func completePlanIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeSuggestions("plans", planSuggestions, args, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeProfiles completes credential profile names.
+func completeProfiles(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeSuggestions("profiles", profileSuggestions, args, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeMappingIDs completes mapping IDs, described as "main -> test".
+func completeMappingIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeSuggestions("mappings", mappingSuggestions, args, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// firstArgOnly limits a completion to the first positional argument, for
+// commands that take exactly one.
+func firstArgOnly(complete completionFunc) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return complete(cmd, args, toComplete)
+	}
+}
+
+// completeValues completes a flag with a fixed set of values.
+func completeValues(values ...string) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		var matches []string
+		for _, v := range values {
+			if strings.HasPrefix(strings.ToLower(v), strings.ToLower(toComplete)) {
+				matches = append(matches, v)
+			}
+		}
+		return matches, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeList completes a comma-separated list flag, such as --status, one
+// element at a time, leaving out the values already given.
+func completeList(values ...string) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		given, last := "", toComplete
+		if i := strings.LastIndex(toComplete, ","); i >= 0 {
+			given, last = toComplete[:i+1], toComplete[i+1:]
+		}
+		done := strings.Split(strings.ToUpper(given), ",")
+
+		var matches []string
+		for _, v := range values {
+			if !slices.Contains(done, v) && strings.HasPrefix(strings.ToLower(v), strings.ToLower(last)) {
+				matches = append(matches, given+v)
+			}
+		}
+		return matches, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+}
+
+// completeSuggestions returns "value\tdescription" pairs of a kind that start
+// with toComplete, leaving out values already given as arguments.
+func completeSuggestions(kind string, load func(*client.APIClient) ([]suggestion, error), args []string, toComplete string) []string {
+	var matches []string
+	for _, s := range cachedSuggestions(kind, load) {
+		if strings.HasPrefix(s.Value, toComplete) && !slices.Contains(args, s.Value) {
+			matches = append(matches, s.Value+"\t"+s.Description)
+		}
+	}
+	return matches
+}
+
+// completionCache is the on-disk cache of suggestions, keyed by kind and API URL.
+type completionCache struct {
+	Entries map[string]completionCacheEntry `json:"entries"`
+}
+
+type completionCacheEntry struct {
+	At          time.Time    `json:"at"`
+	Suggestions []suggestion `json:"suggestions"`
+}
+
+// cachedSuggestions returns suggestions of a kind for the configured API,
+// loading them when the cached copy is missing or stale. Failures produce no
+// suggestions rather than an error, since there's nowhere to show one.
+func cachedSuggestions(kind string, load func(*client.APIClient) ([]suggestion, error)) []suggestion {
+	apiURL := viper.GetString("api_url")
+	key := kind + " " + apiURL
+	path := completionCachePath()
+
+	cache := readCompletionCache(path)
+	if entry, ok := cache.Entries[key]; ok && time.Since(entry.At) < completionCacheTTL {
+		return entry.Suggestions
+	}
+
+	// A short timeout, so an unreachable API doesn't freeze the user's shell.
+	apiClient := &client.APIClient{BaseURL: apiURL, HTTPClient: &http.Client{Timeout: completionFetchTimeout}}
+	suggestions, err := load(apiClient)
+	if err != nil {
+		cobra.CompDebugln("ato: completion failed: "+err.Error(), false)
+		return nil
+	}
+
+	for k, entry := range cache.Entries {
+		if time.Since(entry.At) >= completionCacheTTL {
+			delete(cache.Entries, k)
+		}
+	}
+	cache.Entries[key] = completionCacheEntry{At: time.Now(), Suggestions: suggestions}
+	if path != "" && os.MkdirAll(filepath.Dir(path), 0o700) == nil {
+		export.WriteOutput(path, func(w io.Writer) error {
+			return json.NewEncoder(w).Encode(cache)
+		})
+	}
+	return suggestions
+}
+
+// completionCachePath is the cache file under the user's cache directory, or
+// "" when there isn't one.
+func completionCachePath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "ato", "completion.json")
+}
+
+func readCompletionCache(path string) completionCache {
+	cache := completionCache{Entries: make(map[string]completionCacheEntry)}
+	if path == "" {
+		return cache
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || json.Unmarshal(data, &cache) != nil || cache.Entries == nil {
+		return completionCache{Entries: make(map[string]completionCacheEntry)}
+	}
+	return cache
+}
@@ -36,7 +36,7 @@ var configSetCmd = &cobra.Command{
 		home, err := os.UserHomeDir()
 		if err != nil {
 			style.Error("Unable to find home directory: %v", err)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Define the full path for the config file.
@@ -45,7 +45,7 @@ var configSetCmd = &cobra.Command{
 		// Use WriteConfigAs to create or overwrite the configuration file.
 		if err := viper.WriteConfigAs(configPath); err != nil {
 			style.Error("Unable to save config file: %v", err)
-			os.Exit(1)
+			exit(1)
 		}
 
 		style.Success("API URL has been saved to %s", configPath)
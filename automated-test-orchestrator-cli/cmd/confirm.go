@@ -0,0 +1,37 @@
+// automated-test-orchestrator-cli/cmd/confirm.go
+package cmd
+
+import (
+	"os"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/automated-test-orchestrator/cli-go/internal/style"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// addYesFlag adds the --yes flag used by commands that delete data.
+func addYesFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+}
+
+// confirmDestructive asks the user to confirm a deletion unless --yes was given.
+// Without a terminal there is nobody to ask, so it exits with an error instead of
+// deleting anything.
+func confirmDestructive(cmd *cobra.Command, message string) bool {
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		return true
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		style.Error("Refusing to delete without confirmation because standard input is not a terminal. Pass --yes to proceed.")
+		exit(1)
+	}
+
+	confirmed := false
+	prompt := &survey.Confirm{Message: message, Default: false}
+	if err := survey.AskOne(prompt, &confirmed); err != nil {
+		return false
+	}
+	return confirmed
+}
@@ -2,48 +2,85 @@
 package cmd
 
 import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/automated-test-orchestrator/cli-go/internal/client"
 	"github.com/automated-test-orchestrator/cli-go/internal/display"
 	"github.com/automated-test-orchestrator/cli-go/internal/errors"
 	"github.com/automated-test-orchestrator/cli-go/internal/model"
 	"github.com/automated-test-orchestrator/cli-go/internal/style"
+	"github.com/briandowns/spinner"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
 )
 
 // credsCmd represents the creds command group.
 var credsCmd = &cobra.Command{
 	Use:   "creds",
 	Short: "Manage secure credential profiles",
-	Long:  `Add, list, or delete credential profiles used to connect to the integration platform.`,
+	Long:  `Add, update, rotate, test, list, or delete credential profiles used to connect to the integration platform.`,
 }
 
 // credsAddCmd represents the 'creds add' command.
 var credsAddCmd = &cobra.Command{
 	Use:   "add <profile>",
 	Short: "Add a new credential profile",
-	Long:  `Interactively prompts for details to add a new credential profile (e.g., "dev-account").`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Adds a new credential profile (e.g., "dev-account").
+
+Details can be supplied without a terminal, for use in CI and containers:
+  --from-file     a YAML or JSON file with accountId, username, passwordOrToken
+                  and executionInstanceId
+  --from-env      environment variables <PREFIX>_ACCOUNT_ID, <PREFIX>_USERNAME,
+                  <PREFIX>_PASSWORD (or <PREFIX>_TOKEN) and <PREFIX>_EXECUTION_INSTANCE_ID
+  flags           --account-id, --username and --execution-instance-id
+  --password-stdin  read the password or token from standard input
+
+Later sources override earlier ones in the order listed. The password is never
+accepted as a flag. Any details still missing are prompted for interactively,
+but only when standard input is a terminal.`,
+	Example: `  ato creds add dev-account
+  echo "$ATO_TOKEN" | ato creds add ci --account-id acme-123 --username ci-bot --execution-instance-id atom-1 --password-stdin
+  ato creds add ci --from-env ATO_CI
+  ato creds add ci --from-file creds.yaml`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		profileName := args[0]
-		apiClient := client.NewAPIClient(viper.GetString("api_url"))
 
-		style.Info("Adding new credentials for profile: %s", style.Cyan(profileName))
-
-		answers := struct {
-			AccountID           string `survey:"accountId"`
-			Username            string `survey:"username"`
-			PasswordOrToken     string `survey:"passwordOrToken"`
-			ExecutionInstanceID string `survey:"executionInstanceId"`
-		}{}
+		answers, err := credentialsFromFlags(cmd)
+		if err != nil {
+			errors.HandleCLIError(nil, err)
+		}
 
-		questions := promptForCredentials()
+		if questions := missingCredentialQuestions(answers); len(questions) > 0 {
+			passwordStdin, _ := cmd.Flags().GetBool("password-stdin")
+			if passwordStdin || !term.IsTerminal(int(os.Stdin.Fd())) {
+				names := make([]string, len(questions))
+				for i, q := range questions {
+					names[i] = q.Name
+				}
+				reason := "Standard input is not a terminal"
+				if passwordStdin {
+					reason = "Standard input was used for the password"
+				}
+				style.Error("Missing credential details: %s", strings.Join(names, ", "))
+				style.Info("%s, so they cannot be prompted for. Supply them with flags, --from-env or --from-file; see 'ato creds add --help'.", reason)
+				exit(1)
+			}
 
-		err := survey.Ask(questions, &answers)
-		if err != nil {
-			style.Warning("Credential creation cancelled.")
-			return
+			style.Info("Adding new credentials for profile: %s", style.Cyan(profileName))
+			if err := survey.Ask(questions, answers); err != nil {
+				style.Warning("Credential creation cancelled.")
+				return
+			}
 		}
 
 		requestData := model.AddCredentialRequest{
@@ -54,6 +91,7 @@ var credsAddCmd = &cobra.Command{
 			ExecutionInstanceID: answers.ExecutionInstanceID,
 		}
 
+		apiClient := newAPIClient()
 		if err := apiClient.AddCredentialProfile(requestData); err != nil {
 			errors.HandleCLIError(nil, err)
 		}
@@ -62,13 +100,514 @@ var credsAddCmd = &cobra.Command{
 	},
 }
 
+// credentialAnswers holds credential details gathered from files, the
+// environment, flags and prompts. The tags serve survey and credential files.
+type credentialAnswers struct {
+	AccountID           string `survey:"accountId" yaml:"accountId"`
+	Username            string `survey:"username" yaml:"username"`
+	PasswordOrToken     string `survey:"passwordOrToken" yaml:"passwordOrToken"`
+	ExecutionInstanceID string `survey:"executionInstanceId" yaml:"executionInstanceId"`
+}
+
+// credentialsFromFlags merges the non-interactive credential sources, in order
+// of increasing precedence: --from-file, --from-env, flags and --password-stdin.
+func credentialsFromFlags(cmd *cobra.Command) (*credentialAnswers, error) {
+	answers := &credentialAnswers{}
+	flags := cmd.Flags()
+
+	if path, _ := flags.GetString("from-file"); path != "" {
+		fromFile, err := readCredentialsFile(path)
+		if err != nil {
+			return nil, err
+		}
+		answers.merge(fromFile)
+	}
+
+	if prefix, _ := flags.GetString("from-env"); prefix != "" {
+		prefix = strings.TrimSuffix(strings.ToUpper(prefix), "_") + "_"
+		password := os.Getenv(prefix + "PASSWORD")
+		if password == "" {
+			password = os.Getenv(prefix + "TOKEN")
+		}
+		answers.merge(credentialAnswers{
+			AccountID:           os.Getenv(prefix + "ACCOUNT_ID"),
+			Username:            os.Getenv(prefix + "USERNAME"),
+			PasswordOrToken:     password,
+			ExecutionInstanceID: os.Getenv(prefix + "EXECUTION_INSTANCE_ID"),
+		})
+	}
+
+	accountID, _ := flags.GetString("account-id")
+	username, _ := flags.GetString("username")
+	instanceID, _ := flags.GetString("execution-instance-id")
+	answers.merge(credentialAnswers{AccountID: accountID, Username: username, ExecutionInstanceID: instanceID})
+
+	if passwordStdin, _ := flags.GetBool("password-stdin"); passwordStdin {
+		password, err := readPasswordStdin()
+		if err != nil {
+			return nil, err
+		}
+		answers.PasswordOrToken = password
+	}
+
+	return answers, nil
+}
+
+// merge copies every non-empty field of other into a.
+func (a *credentialAnswers) merge(other credentialAnswers) {
+	if other.AccountID != "" {
+		a.AccountID = other.AccountID
+	}
+	if other.Username != "" {
+		a.Username = other.Username
+	}
+	if other.PasswordOrToken != "" {
+		a.PasswordOrToken = other.PasswordOrToken
+	}
+	if other.ExecutionInstanceID != "" {
+		a.ExecutionInstanceID = other.ExecutionInstanceID
+	}
+}
+
+// readCredentialsFile reads credential details from a YAML or JSON file. It
+// warns when the file is readable by other users, since it may hold a secret.
+func readCredentialsFile(path string) (credentialAnswers, error) {
+	var answers credentialAnswers
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return answers, fmt.Errorf("failed to read credentials file: %w", err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		style.Warning("%s is accessible by other users (mode %s). Consider 'chmod 600 %s'.", path, info.Mode().Perm(), path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return answers, fmt.Errorf("failed to read credentials file: %w", err)
+	}
+	// YAML is a superset of JSON, so one decoder handles both.
+	if err := yaml.Unmarshal(data, &answers); err != nil {
+		return answers, fmt.Errorf("failed to parse credentials file %s: %w", path, err)
+	}
+	return answers, nil
+}
+
+// readPasswordStdin reads the password or token from the first line of stdin.
+func readPasswordStdin() (string, error) {
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", fmt.Errorf("--password-stdin requires the password to be piped in, e.g. 'echo \"$TOKEN\" | ato creds add ...'")
+	}
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", fmt.Errorf("failed to read password from stdin: %w", err)
+	}
+	password := strings.TrimRight(line, "\r\n")
+	if password == "" {
+		return "", fmt.Errorf("no password was provided on stdin")
+	}
+	return password, nil
+}
+
+// missingCredentialQuestions returns the prompts for the details not yet supplied.
+func missingCredentialQuestions(answers *credentialAnswers) []*survey.Question {
+	supplied := map[string]bool{
+		"accountId":           answers.AccountID != "",
+		"username":            answers.Username != "",
+		"passwordOrToken":     answers.PasswordOrToken != "",
+		"executionInstanceId": answers.ExecutionInstanceID != "",
+	}
+
+	var questions []*survey.Question
+	for _, q := range promptForCredentials() {
+		if !supplied[q.Name] {
+			questions = append(questions, q)
+		}
+	}
+	return questions
+}
+
+// credsUpdateCmd represents the 'creds update' command.
+var credsUpdateCmd = &cobra.Command{
+	Use:   "update <profile>",
+	Short: "Change individual fields of a credential profile",
+	Long: `Changes only the fields that are given; everything else keeps its stored value.
+The password or token is read from standard input with --password-stdin, or
+prompted for with --password-prompt. It is never accepted as a flag.`,
+	Example: `  ato creds update dev-account --execution-instance-id atom-2
+  echo "$NEW_TOKEN" | ato creds update dev-account --password-stdin`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: firstArgOnly(completeProfiles),
+	Run: func(cmd *cobra.Command, args []string) {
+		profileName := args[0]
+		flags := cmd.Flags()
+
+		req := model.UpdateCredentialRequest{}
+		if flags.Changed("account-id") {
+			v, _ := flags.GetString("account-id")
+			req.AccountID = &v
+		}
+		if flags.Changed("username") {
+			v, _ := flags.GetString("username")
+			req.Username = &v
+		}
+		if flags.Changed("execution-instance-id") {
+			v, _ := flags.GetString("execution-instance-id")
+			req.ExecutionInstanceID = &v
+		}
+
+		passwordStdin, _ := flags.GetBool("password-stdin")
+		passwordPrompt, _ := flags.GetBool("password-prompt")
+		if passwordStdin && passwordPrompt {
+			errors.HandleCLIError(nil, fmt.Errorf("--password-stdin and --password-prompt cannot be used together"))
+		}
+		if passwordStdin || passwordPrompt {
+			password, err := readNewSecret(passwordStdin)
+			if err != nil {
+				errors.HandleCLIError(nil, err)
+			}
+			req.PasswordOrToken = &password
+		}
+
+		if req == (model.UpdateCredentialRequest{}) {
+			style.Warning("Nothing to update. Provide at least one of --account-id, --username, --execution-instance-id, --password-stdin or --password-prompt.")
+			return
+		}
+
+		apiClient := newAPIClient()
+		updated, err := apiClient.UpdateCredentialProfile(profileName, req)
+		if err != nil {
+			errors.HandleCLIError(nil, err)
+		}
+
+		style.Success("Profile \"%s\" has been updated.", profileName)
+		display.PrintCredentialProfiles([]model.CliCredentialProfile{*updated})
+	},
+}
+
+// credsRotateCmd represents the 'creds rotate' command.
+var credsRotateCmd = &cobra.Command{
+	Use:   "rotate <profile>",
+	Short: "Replace the password or token of a credential profile",
+	Long: `Swaps the secret of a credential profile in a single update, so pipelines using
+the profile never see it missing. The new secret is read from standard input when
+it is piped in, and prompted for otherwise.
+
+With --verify-component, the new secret is checked first: it is saved under a
+temporary profile, used to resolve the given component in a throwaway plan, and
+only applied if that succeeds. The temporary profile and plan are always removed.`,
+	Example: `  ato creds rotate dev-account
+  echo "$NEW_TOKEN" | ato creds rotate dev-account --verify-component 1a2b3c4d-...`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: firstArgOnly(completeProfiles),
+	Run: func(cmd *cobra.Command, args []string) {
+		profileName := args[0]
+		verifyComponent, _ := cmd.Flags().GetString("verify-component")
+
+		apiClient := newAPIClient()
+		existing, err := findCredentialProfile(apiClient, profileName)
+		if err != nil {
+			errors.HandleCLIError(nil, err)
+		}
+
+		secret, err := readNewSecret(!term.IsTerminal(int(os.Stdin.Fd())))
+		if err != nil {
+			errors.HandleCLIError(nil, err)
+		}
+
+		if verifyComponent != "" {
+			s := spinner.New(spinner.CharSets[11], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+			s.Suffix = " Verifying the new secret..."
+			s.Start()
+
+			tempProfile := fmt.Sprintf("%s-rotate-%d", profileName, time.Now().Unix())
+			err := apiClient.AddCredentialProfile(model.AddCredentialRequest{
+				ProfileName:         tempProfile,
+				AccountID:           existing.Credentials.AccountID,
+				Username:            existing.Credentials.Username,
+				PasswordOrToken:     secret,
+				ExecutionInstanceID: existing.Credentials.ExecutionInstanceID,
+			})
+			if err != nil {
+				errors.HandleCLIError(s, fmt.Errorf("failed to create temporary profile for verification: %w", err))
+			}
+
+			_, verifyErr := probeCredentials(apiClient, tempProfile, "COMPONENT", verifyComponent, nil)
+			if err := apiClient.DeleteCredentialProfile(tempProfile); err != nil {
+				s.Stop()
+				style.Warning("Could not remove temporary profile \"%s\": %s", tempProfile, errors.FormatError(err))
+				s.Start()
+			}
+			s.Stop()
+
+			if verifyErr != nil {
+				style.Error("The new secret could not be verified: %s", errors.FormatError(verifyErr))
+				style.Info("Profile \"%s\" was not changed.", profileName)
+				exit(1)
+			}
+			style.Success("The new secret resolved component %s.", verifyComponent)
+		}
+
+		if _, err := apiClient.UpdateCredentialProfile(profileName, model.UpdateCredentialRequest{PasswordOrToken: &secret}); err != nil {
+			errors.HandleCLIError(nil, err)
+		}
+
+		style.Success("The secret for profile \"%s\" has been rotated.", profileName)
+	},
+}
+
+// credsTestCmd represents the 'creds test' command.
+var credsTestCmd = &cobra.Command{
+	Use:   "test <profile>",
+	Short: "Check that a credential profile can reach the integration platform",
+	Long: `Runs a minimal round-trip through the orchestrator with the profile and reports
+each step separately:
+
+  Profile              the profile is saved on the server
+  Authentication       the platform accepts the credentials (a component is resolved)
+  Account reachability discovery can read the component from the account
+  Execution instance   a process runs on the profile's execution instance
+
+The component to resolve is given with --component, or taken from the first mapping.
+The execution instance is only checked when --test names a process to run, because
+that actually executes it. All throwaway plans are deleted afterwards.`,
+	Example: `  ato creds test dev-account
+  ato creds test dev-account --component 1a2b3c4d-... --test 5e6f7a8b-...`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: firstArgOnly(completeProfiles),
+	Run: func(cmd *cobra.Command, args []string) {
+		profileName := args[0]
+		componentID, _ := cmd.Flags().GetString("component")
+		testID, _ := cmd.Flags().GetString("test")
+
+		apiClient := newAPIClient()
+		checks := runCredentialChecks(apiClient, profileName, componentID, testID)
+
+		display.PrintChecks(checks)
+		if failed := display.CountFailedChecks(checks); failed > 0 {
+			style.Error("%d check(s) failed for profile \"%s\".", failed, profileName)
+			exit(1)
+		}
+		style.Success("Profile \"%s\" passed all checks that were run.", profileName)
+	},
+}
+
+// runCredentialChecks performs the 'creds test' checks in order, skipping those
+// that depend on an earlier failure.
+func runCredentialChecks(apiClient *client.APIClient, profileName, componentID, testID string) []display.CheckResult {
+	const (
+		checkProfile  = "Profile"
+		checkAuth     = "Authentication"
+		checkAccount  = "Account reachability"
+		checkInstance = "Execution instance"
+	)
+	skipRest := func(checks []display.CheckResult, reason string, names ...string) []display.CheckResult {
+		for _, name := range names {
+			checks = append(checks, display.CheckResult{Name: name, Status: display.CheckSkip, Details: reason})
+		}
+		return checks
+	}
+
+	s := spinner.New(spinner.CharSets[11], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+	s.Suffix = " Looking up profile..."
+	s.Start()
+	defer s.Stop()
+
+	var checks []display.CheckResult
+	profile, err := findCredentialProfile(apiClient, profileName)
+	if err != nil {
+		checks = append(checks, display.CheckResult{Name: checkProfile, Status: display.CheckFail, Details: errors.FormatError(err)})
+		return skipRest(checks, "Profile not available", checkAuth, checkAccount, checkInstance)
+	}
+	checks = append(checks, display.CheckResult{Name: checkProfile, Status: display.CheckPass,
+		Details: fmt.Sprintf("Account %s, user %s", profile.Credentials.AccountID, profile.Credentials.Username)})
+
+	if componentID == "" {
+		s.Suffix = " Choosing a component to resolve..."
+		componentID, err = firstMappedComponent(apiClient)
+		if err != nil {
+			checks = append(checks, display.CheckResult{Name: checkAuth, Status: display.CheckSkip, Details: err.Error()})
+			return skipRest(checks, "No component to resolve", checkAccount, checkInstance)
+		}
+	}
+
+	s.Suffix = fmt.Sprintf(" Resolving component %s...", componentID)
+	plan, err := probeCredentials(apiClient, profileName, "COMPONENT", componentID, nil)
+	if discoveryErr, ok := err.(*probeDiscoveryError); ok {
+		// The plan was created, so the credentials were accepted.
+		checks = append(checks, display.CheckResult{Name: checkAuth, Status: display.CheckPass, Details: fmt.Sprintf("Resolved component %s", componentID)})
+		checks = append(checks, display.CheckResult{Name: checkAccount, Status: display.CheckFail, Details: discoveryErr.reason})
+		return skipRest(checks, "Account not reachable", checkInstance)
+	}
+	if err != nil {
+		// Input resolution runs while the plan is created, so both bad credentials and
+		// an unknown component end up here; the API does not tell them apart.
+		checks = append(checks, display.CheckResult{Name: checkAuth, Status: display.CheckFail,
+			Details: fmt.Sprintf("Could not resolve component %s: %s. Check the account ID, username and token, and that the component exists in the account.", componentID, errors.FormatError(err))})
+		return skipRest(checks, "Authentication failed", checkAccount, checkInstance)
+	}
+	checks = append(checks, display.CheckResult{Name: checkAuth, Status: display.CheckPass, Details: fmt.Sprintf("Resolved component %s", componentID)})
+	name := componentID
+	if len(plan.PlanComponents) > 0 && plan.PlanComponents[0].ComponentName != nil {
+		name = *plan.PlanComponents[0].ComponentName
+	}
+	checks = append(checks, display.CheckResult{Name: checkAccount, Status: display.CheckPass, Details: fmt.Sprintf("Read %q from account %s", name, profile.Credentials.AccountID)})
+
+	if testID == "" {
+		return append(checks, display.CheckResult{Name: checkInstance, Status: display.CheckSkip,
+			Details: fmt.Sprintf("Not checked; use --test <processId> to run a process on %s", profile.Credentials.ExecutionInstanceID)})
+	}
+
+	s.Suffix = fmt.Sprintf(" Running test %s on %s...", testID, profile.Credentials.ExecutionInstanceID)
+	checks = append(checks, checkExecutionInstance(apiClient, profile, testID))
+	return checks
+}
+
+// checkExecutionInstance runs a single process through a throwaway TEST plan. A
+// completed execution shows the instance is usable, whatever the test's own verdict.
+func checkExecutionInstance(apiClient *client.APIClient, profile *model.CliCredentialProfile, testID string) display.CheckResult {
+	result := display.CheckResult{Name: "Execution instance", Status: display.CheckFail}
+
+	resolved := false
+	var plan *model.CliTestPlan
+	_, err := probeCredentials(apiClient, profile.ProfileName, "TEST", testID, func(probe *model.CliTestPlan) error {
+		resolved = true
+		if err := apiClient.InitiateExecution(probe.ID, []string{testID}, profile.ProfileName); err != nil {
+			return err
+		}
+		var err error
+		plan, err = apiClient.PollForExecutionCompletion(context.Background(), probe.ID)
+		return err
+	})
+	if err != nil {
+		switch discoveryErr, ok := err.(*probeDiscoveryError); {
+		case ok:
+			result.Details = discoveryErr.reason
+		case !resolved:
+			result.Details = fmt.Sprintf("Could not resolve test %s as a process: %s", testID, errors.FormatError(err))
+		case plan != nil && plan.FailureReason != nil:
+			result.Details = *plan.FailureReason
+		default:
+			result.Details = errors.FormatError(err)
+		}
+		return result
+	}
+
+	result.Status = display.CheckPass
+	result.Details = fmt.Sprintf("Ran %s on %s", testID, profile.Credentials.ExecutionInstanceID)
+	for _, r := range plan.EnrichedResults() {
+		if r.Status != "SUCCESS" {
+			// The instance ran the process; the failure belongs to the test itself.
+			result.Status = display.CheckWarn
+			result.Details += fmt.Sprintf(", but the test reported %s", r.Status)
+			break
+		}
+	}
+	return result
+}
+
+// firstMappedComponent returns the lowest main component ID among the mappings,
+// so repeated checks resolve the same component.
+func firstMappedComponent(apiClient *client.APIClient) (string, error) {
+	mappings, err := apiClient.GetAllMappings()
+	if err != nil {
+		return "", fmt.Errorf("could not fetch mappings to pick a component: %s", errors.FormatError(err))
+	}
+	first := ""
+	for _, m := range mappings {
+		if first == "" || m.MainComponentID < first {
+			first = m.MainComponentID
+		}
+	}
+	if first == "" {
+		return "", fmt.Errorf("no mappings to pick a component from; use --component <id>")
+	}
+	return first, nil
+}
+
+// findCredentialProfile returns the display-safe details of a saved profile.
+func findCredentialProfile(apiClient *client.APIClient, profileName string) (*model.CliCredentialProfile, error) {
+	profiles, err := apiClient.ListCredentialProfiles()
+	if err != nil {
+		return nil, err
+	}
+	for i := range profiles {
+		if profiles[i].ProfileName == profileName {
+			return &profiles[i], nil
+		}
+	}
+	return nil, fmt.Errorf("profile \"%s\" not found; use 'ato creds list' to see saved profiles", profileName)
+}
+
+// readNewSecret reads a password or token from piped stdin, or prompts for it
+// twice when stdin is a terminal.
+func readNewSecret(fromStdin bool) (string, error) {
+	if fromStdin {
+		return readPasswordStdin()
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", fmt.Errorf("cannot prompt for the new secret because standard input is not a terminal; pipe it in instead")
+	}
+
+	var first, second string
+	if err := survey.AskOne(&survey.Password{Message: "Enter the new Password or Token:"}, &first, survey.WithValidator(survey.Required)); err != nil {
+		return "", fmt.Errorf("cancelled")
+	}
+	if err := survey.AskOne(&survey.Password{Message: "Confirm the new Password or Token:"}, &second); err != nil {
+		return "", fmt.Errorf("cancelled")
+	}
+	if first != second {
+		return "", fmt.Errorf("the secrets do not match")
+	}
+	return first, nil
+}
+
+// probeCredentials resolves a component through a throwaway plan created with the
+// given profile, calls use with the discovered plan if it is given, then deletes the
+// plan. Input resolution happens when the plan is created, so an error from
+// InitiateDiscovery means the platform rejected the credentials or could not find
+// the component; a failed discovery is returned as a *probeDiscoveryError.
+func probeCredentials(apiClient *client.APIClient, profileName, planType, componentID string, use func(plan *model.CliTestPlan) error) (*model.CliTestPlan, error) {
+	planName := fmt.Sprintf("ato-probe-%s-%s", profileName, time.Now().Format("20060102-150405"))
+	planID, err := apiClient.InitiateDiscovery(planName, planType, []string{componentID}, nil, nil, profileName, false)
+	if err != nil {
+		return nil, err
+	}
+	defer apiClient.DeleteTestPlan(planID)
+
+	plan, err := apiClient.PollForPlanCompletion(context.Background(), planID)
+	if err != nil {
+		reason := errors.FormatError(err)
+		if plan != nil && plan.FailureReason != nil {
+			reason = *plan.FailureReason
+		}
+		return plan, &probeDiscoveryError{reason: reason}
+	}
+	if use != nil {
+		if err := use(plan); err != nil {
+			return plan, err
+		}
+	}
+	return plan, nil
+}
+
+// probeDiscoveryError means a probe plan was created, so the platform accepted the
+// credentials and found the component, but its discovery then failed.
+type probeDiscoveryError struct {
+	reason string // The plan's failure reason, or why polling failed
+}
+
+func (e *probeDiscoveryError) Error() string {
+	return "discovery failed: " + e.reason
+}
+
 // credsListCmd represents the 'creds list' command.
 var credsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all saved credential profiles",
 	Long:  `Retrieves and displays a list of all configured credential profiles.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		apiClient := client.NewAPIClient(viper.GetString("api_url"))
+		apiClient := newAPIClient()
 		profiles, err := apiClient.ListCredentialProfiles()
 		if err != nil {
 			errors.HandleCLIError(nil, err)
@@ -88,11 +627,24 @@ var credsListCmd = &cobra.Command{
 var credsRemoveCmd = &cobra.Command{
 	Use:   "rm <profile>",
 	Short: "Remove a credential profile",
-	Long:  `Removes a credential profile by its name.`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Removes a credential profile by its name. The profile is shown before asking for
+confirmation; use --yes to skip the prompt in scripts.`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: firstArgOnly(completeProfiles),
 	Run: func(cmd *cobra.Command, args []string) {
 		profileName := args[0]
-		apiClient := client.NewAPIClient(viper.GetString("api_url"))
+		apiClient := newAPIClient()
+
+		profile, err := findCredentialProfile(apiClient, profileName)
+		if err != nil {
+			errors.HandleCLIError(nil, err)
+		}
+		display.PrintCredentialProfiles([]model.CliCredentialProfile{*profile})
+		style.Warning("Discoveries and executions that use this profile will fail until it is added again.")
+		if !confirmDestructive(cmd, fmt.Sprintf("Permanently remove profile \"%s\"?", profileName)) {
+			style.Warning("Nothing was removed.")
+			return
+		}
 
 		if err := apiClient.DeleteCredentialProfile(profileName); err != nil {
 			errors.HandleCLIError(nil, err)
@@ -132,8 +684,32 @@ func init() {
 	rootCmd.AddCommand(credsCmd)
 
 	credsCmd.AddCommand(credsAddCmd)
+	credsAddCmd.Flags().String("account-id", "", "Integration platform account ID")
+	credsAddCmd.Flags().String("username", "", "Integration platform username")
+	credsAddCmd.Flags().String("execution-instance-id", "", "ID of the execution instance to use for execution")
+	credsAddCmd.Flags().Bool("password-stdin", false, "Read the password or token from standard input")
+	credsAddCmd.Flags().String("from-env", "", "Read details from <PREFIX>_ACCOUNT_ID, <PREFIX>_USERNAME, <PREFIX>_PASSWORD and <PREFIX>_EXECUTION_INSTANCE_ID")
+	credsAddCmd.Flags().String("from-file", "", "Read details from a YAML or JSON file")
+	credsAddCmd.Flags().SortFlags = false
+	credsCmd.AddCommand(credsUpdateCmd)
+	credsUpdateCmd.Flags().String("account-id", "", "New integration platform account ID")
+	credsUpdateCmd.Flags().String("username", "", "New integration platform username")
+	credsUpdateCmd.Flags().String("execution-instance-id", "", "New execution instance ID")
+	credsUpdateCmd.Flags().Bool("password-stdin", false, "Read the new password or token from standard input")
+	credsUpdateCmd.Flags().Bool("password-prompt", false, "Prompt for the new password or token")
+	credsUpdateCmd.Flags().SortFlags = false
+
+	credsCmd.AddCommand(credsRotateCmd)
+	credsRotateCmd.Flags().String("verify-component", "", "Component ID to resolve with the new secret before applying it")
+
+	credsCmd.AddCommand(credsTestCmd)
+	credsTestCmd.Flags().String("component", "", "Component ID to resolve (defaults to the first mapped main component)")
+	credsTestCmd.Flags().String("test", "", "Test process ID to run on the execution instance")
+	credsTestCmd.Flags().SortFlags = false
+
 	credsCmd.AddCommand(credsListCmd)
 	credsCmd.AddCommand(credsRemoveCmd)
+	addYesFlag(credsRemoveCmd)
 
 	credsCmd.Flags().SortFlags = false
 }
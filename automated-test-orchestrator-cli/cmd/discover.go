@@ -3,6 +3,7 @@ package cmd
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -11,10 +12,10 @@ import (
 	"github.com/automated-test-orchestrator/cli-go/internal/client"
 	"github.com/automated-test-orchestrator/cli-go/internal/csv"
 	"github.com/automated-test-orchestrator/cli-go/internal/display"
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
 	"github.com/automated-test-orchestrator/cli-go/internal/style"
 	"github.com/briandowns/spinner"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // discoverCmd represents the discover command
@@ -49,14 +50,14 @@ or interactively if no other input is given.`,
 			if err != nil {
 				s.Stop()
 				style.Error("Failed to open file: %v", err)
-				os.Exit(1)
+				exit(1)
 			}
 			defer file.Close()
 			componentIds, err = csv.ParseComponentIdCsv(file)
 			if err != nil {
 				s.Stop()
 				style.Error("Failed to parse CSV file: %v", err)
-				os.Exit(1)
+				exit(1)
 			}
 		}
 
@@ -77,7 +78,7 @@ or interactively if no other input is given.`,
 			componentIds, err = promptForComponentIDs(dependencies)
 			if err != nil {
 				style.Error("Error during interactive prompt: %v", err)
-				os.Exit(1)
+				exit(1)
 			}
 			s.Start()
 		}
@@ -95,37 +96,58 @@ or interactively if no other input is given.`,
 		totalInputs := len(componentIds) + len(componentNames) + len(componentFolders)
 		s.Suffix = fmt.Sprintf(" Creating test plan '%s' with %d input(s)%s...", planName, totalInputs, discoveryMode)
 
-		apiClient := client.NewAPIClient(viper.GetString("api_url"))
+		apiClient := newAPIClient()
 		planID, err := apiClient.InitiateDiscovery(planName, planType, componentIds, componentNames, componentFolders, creds, dependencies)
 		if err != nil {
 			s.Stop()
 			style.Error("Failed to initiate discovery: %v", err)
-			os.Exit(1)
+			exit(1)
 		}
+		rememberPlan(planID)
 
-		s.Suffix = fmt.Sprintf(" Test plan created (ID: %s). Waiting for component discovery...", style.ID(planID))
-		finalPlan, err := apiClient.PollForPlanCompletion(planID)
-		if err != nil {
+		if detach, _ := cmd.Flags().GetBool("detach"); detach {
 			s.Stop()
-			style.Error("Test plan creation failed.")
-			if finalPlan != nil && finalPlan.FailureReason != nil {
-				style.Error("Reason: %s", *finalPlan.FailureReason)
-			} else {
-				style.Error("Reason: %v", err)
-			}
-			os.Exit(1)
+			printDetached(planID, "Discovery started")
+			return
 		}
 
-		s.Stop()
-		style.Success("Test plan '%s' processing complete!", finalPlan.Name)
-		style.PrintKV("Test Plan ID", style.ID(planID))
-		fmt.Println()
-		display.PrintDiscoveryResult(finalPlan)
-		fmt.Println()
-		style.Info("To execute tests, use the 'execute' command with the Plan ID.")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		awaitDiscovery(apiClient, s, planID, timeout)
 	},
 }
 
+// awaitDiscovery waits for a plan's discovery to finish and prints what was
+// found, exiting non-zero if it fails or times out. Shared by 'discover' and
+// 'test-plans wait'.
+func awaitDiscovery(apiClient *client.APIClient, s *spinner.Spinner, planID string, timeout time.Duration) {
+	s.Suffix = fmt.Sprintf(" Waiting for component discovery of plan %s...", style.ID(planID))
+	finalPlan, err := withTimeout(timeout, func(ctx context.Context) (*model.CliTestPlan, error) {
+		return apiClient.PollForPlanCompletion(ctx, planID)
+	})
+	if err != nil {
+		s.Stop()
+		if te, ok := err.(*timeoutError); ok {
+			printStillRunning(planID, "DISCOVERING", te.timeout)
+			exit(1)
+		}
+		style.Error("Test plan creation failed.")
+		if finalPlan != nil && finalPlan.FailureReason != nil {
+			style.Error("Reason: %s", *finalPlan.FailureReason)
+		} else {
+			style.Error("Reason: %v", err)
+		}
+		exit(1)
+	}
+
+	s.Stop()
+	style.Success("Test plan '%s' processing complete!", finalPlan.Name)
+	style.PrintKV("Test Plan ID", style.ID(planID))
+	fmt.Println()
+	display.PrintDiscoveryResult(finalPlan)
+	fmt.Println()
+	style.Info("To execute tests, use the 'execute' command with the Plan ID.")
+}
+
 func promptForComponentIDs(dependencies bool) ([]string, error) {
 	var ids []string
 	reader := bufio.NewReader(os.Stdin)
@@ -160,8 +182,14 @@ func init() {
 	discoverCmd.Flags().BoolP("dependencies", "d", false, "Discover all dependencies for the provided components")
 	discoverCmd.Flags().StringP("creds", "c", "", "The name of the credential profile to use (required)")
 
+	discoverCmd.Flags().Bool("detach", false, "Create the plan and print its ID without waiting for discovery")
+	discoverCmd.Flags().Duration("timeout", 0, "Give up waiting after this long (e.g. 10m); 0 waits indefinitely")
+
 	discoverCmd.MarkFlagRequired("plan-name")
 	discoverCmd.MarkFlagRequired("creds")
 
+	discoverCmd.RegisterFlagCompletionFunc("type", completeValues("COMPONENT", "TEST"))
+	discoverCmd.RegisterFlagCompletionFunc("creds", completeProfiles)
+
 	discoverCmd.Flags().SortFlags = false
 }
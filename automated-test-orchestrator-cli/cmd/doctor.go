@@ -0,0 +1,147 @@
+// automated-test-orchestrator-cli/cmd/doctor.go
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/client"
+	"github.com/automated-test-orchestrator/cli-go/internal/display"
+	"github.com/automated-test-orchestrator/cli-go/internal/doctor"
+	"github.com/automated-test-orchestrator/cli-go/internal/style"
+	"github.com/briandowns/spinner"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// doctorCmd represents the doctor command
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose the CLI's configuration and its connection to the API",
+	Long: `Checks, in order, everything the CLI needs to work and tells you how to fix
+what isn't right:
+
+  Config file          which config file is used, and whether it can be read
+  Config permissions   that 'ato config set' can write ~/.ato.yaml
+  API URL              the API URL, and whether it came from --api-url, ATO_API_URL,
+                       the config file or the default (in that order of precedence)
+  API reachability     that the server answers
+  Latency              how long requests take
+  TLS                  the server certificate for https URLs; plain HTTP to remote hosts
+  Server version       that the server speaks the same API version as the CLI
+  Credential profiles  that profiles are saved, and that --creds exists if given
+
+Use --json for a report to paste into support tickets. Exits with a non-zero
+status if any check fails.`,
+	Example: `  ato doctor
+  ato doctor --creds dev-account
+  ato doctor --json > doctor.json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		asJSON, _ := cmd.Flags().GetBool("json")
+		creds, _ := cmd.Flags().GetString("creds")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+
+		home, err := os.UserHomeDir()
+		if err != nil {
+			style.Error("Unable to find home directory: %v", err)
+			exit(1)
+		}
+
+		opts := doctor.Options{
+			DefaultConfigFile: filepath.Join(home, ".ato.yaml"),
+			APIURL:            viper.GetString(configKeyApiUrl),
+			APIVersion:        client.APIVersion,
+			Profile:           creds,
+			Timeout:           timeout,
+		}
+		// Read the config again: initConfig ignores errors so other commands still run.
+		if err := viper.ReadInConfig(); err != nil {
+			if _, notFound := err.(viper.ConfigFileNotFoundError); !notFound {
+				opts.ConfigErr = err
+			}
+		}
+		opts.ConfigFile = viper.ConfigFileUsed()
+		opts.APIURLSource, opts.Overridden = apiURLSources(opts.ConfigFile)
+
+		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+		s.Suffix = " Running checks..."
+		s.Start()
+		opts.Progress = func(check string) { s.Suffix = fmt.Sprintf(" Checking %s...", check) }
+		checks := doctor.Run(opts)
+		s.Stop()
+
+		failed := display.CountFailedChecks(checks)
+		warnings := display.CountChecks(checks, display.CheckWarn)
+
+		if asJSON {
+			report := doctor.Report{
+				CLIVersion: rootCmd.Version,
+				Platform:   runtime.GOOS + "/" + runtime.GOARCH,
+				APIURL:     opts.APIURL,
+				Checks:     checks,
+				Failed:     failed,
+				Warnings:   warnings,
+			}
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			encoder.SetEscapeHTML(false)
+			if err := encoder.Encode(report); err != nil {
+				style.Error("Failed to encode report: %v", err)
+				exit(1)
+			}
+			if failed > 0 {
+				exit(1)
+			}
+			return
+		}
+
+		display.PrintCheckList(checks)
+		fmt.Println()
+		switch {
+		case failed > 0:
+			style.Error("%d check(s) failed, %d warning(s).", failed, warnings)
+			exit(1)
+		case warnings > 0:
+			style.Warning("No checks failed, %d warning(s).", warnings)
+		default:
+			style.Success("All checks passed.")
+		}
+	},
+}
+
+// apiURLSources describes where the API URL came from, and the lower-precedence
+// sources that also set it. Viper prefers the flag, then ATO_API_URL, then the
+// config file, then the flag's default.
+func apiURLSources(configFile string) (string, []string) {
+	var sources []string
+	if f := rootCmd.PersistentFlags().Lookup("api-url"); f != nil && f.Changed {
+		sources = append(sources, "the --api-url flag")
+	}
+	// Viper ignores ATO_API_URL when it is set but empty.
+	if os.Getenv("ATO_API_URL") != "" {
+		sources = append(sources, "ATO_API_URL")
+	}
+	if viper.InConfig(configKeyApiUrl) {
+		sources = append(sources, configFile)
+	}
+	if len(sources) == 0 {
+		return "the default", nil
+	}
+	return sources[0], sources[1:]
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+	doctorCmd.Flags().StringP("creds", "c", "", "Also check that this credential profile exists")
+	doctorCmd.Flags().Duration("timeout", 10*time.Second, "How long to wait for each network check")
+	doctorCmd.Flags().Bool("json", false, "Print the results as JSON, e.g. to attach to a support ticket")
+
+	doctorCmd.RegisterFlagCompletionFunc("creds", completeProfiles)
+
+	doctorCmd.Flags().SortFlags = false
+}
@@ -2,6 +2,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -9,10 +10,14 @@ import (
 
 	"github.com/automated-test-orchestrator/cli-go/internal/client"
 	"github.com/automated-test-orchestrator/cli-go/internal/display"
+	"github.com/automated-test-orchestrator/cli-go/internal/export"
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+	"github.com/automated-test-orchestrator/cli-go/internal/planwatch"
 	"github.com/automated-test-orchestrator/cli-go/internal/style"
 	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
+	"golang.org/x/term"
 )
 
 // executeCmd represents the execute command
@@ -24,6 +29,12 @@ var executeCmd = &cobra.Command{
 		tests, _ := cmd.Flags().GetString("tests")
 		creds, _ := cmd.Flags().GetString("creds")
 
+		exportTargets, err := exportTargetsFromFlags(cmd)
+		if err != nil {
+			style.Error("%v", err)
+			exit(1)
+		}
+
 		var testsToRun []string
 		if tests != "" {
 			testsToRun = strings.Split(tests, ",")
@@ -42,31 +53,181 @@ var executeCmd = &cobra.Command{
 		}
 		s.Suffix = fmt.Sprintf(" Initiating execution for %s for Plan ID: %s...", executionMessage, style.ID(planID))
 
-		apiClient := client.NewAPIClient(viper.GetString("api_url"))
-		err := apiClient.InitiateExecution(planID, testsToRun, creds)
+		apiClient := newAPIClient()
+		err = apiClient.InitiateExecution(planID, testsToRun, creds)
 		if err != nil {
 			s.Stop()
 			style.Error("Failed to initiate execution: %v", err)
-			os.Exit(1)
+			exit(1)
 		}
+		rememberPlan(planID)
 
-		s.Suffix = " Execution in progress. Waiting for results..."
-		finalPlan, err := apiClient.PollForExecutionCompletion(planID)
-		if err != nil {
+		if detach, _ := cmd.Flags().GetBool("detach"); detach {
 			s.Stop()
+			printDetached(planID, "Execution started")
+			return
+		}
+
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		awaitExecution(apiClient, s, planID, testsToRun, timeout, exportTargets, creds)
+	},
+}
+
+// awaitExecution waits for a plan's execution to finish, reporting progress and
+// failures as results arrive, then prints the report and writes any exports. It
+// exits non-zero if the execution fails or times out, still exporting partial
+// results. selected is the tests the execution was started with, if known.
+// Shared by 'execute' and 'test-plans wait'.
+func awaitExecution(apiClient *client.APIClient, s *spinner.Spinner, planID string, selected []string, timeout time.Duration, exportTargets []export.Target, creds string) {
+	progress := newExecutionProgress(s, selected)
+	s.Suffix = " Execution in progress. Waiting for results..."
+	finalPlan, err := withTimeout(timeout, func(ctx context.Context) (*model.CliTestPlan, error) {
+		return apiClient.PollForExecutionProgress(ctx, planID, progress.update)
+	})
+	if finalPlan == nil {
+		// Timed out mid-poll: fall back to the last state we saw.
+		finalPlan = progress.latest()
+	}
+	if err != nil {
+		s.Stop()
+		if te, ok := err.(*timeoutError); ok {
+			status := "EXECUTING"
+			if finalPlan != nil {
+				status = finalPlan.Status
+			}
+			printStillRunning(planID, status, te.timeout)
+		} else {
 			style.Error("Execution failed.")
 			if finalPlan != nil && finalPlan.FailureReason != nil {
 				style.Error("Reason: %s", *finalPlan.FailureReason)
 			} else {
 				style.Error("Reason: %v", err)
 			}
-			os.Exit(1)
 		}
+		// Still write any partial results so CI has a report to show for the failure.
+		if finalPlan != nil && len(exportTargets) > 0 {
+			writeExports(exportTargets, finalPlan.EnrichedResults(), creds)
+		}
+		exit(1)
+	}
 
-		s.Stop()
-		style.Success("Execution finished.")
-		display.PrintExecutionReport(finalPlan)
-	},
+	s.Stop()
+	style.Success("Execution finished.")
+	display.PrintExecutionReport(finalPlan)
+	if len(exportTargets) > 0 {
+		fmt.Fprintln(color.Output)
+		if err := writeExports(exportTargets, finalPlan.EnrichedResults(), creds); err != nil {
+			exit(1)
+		}
+	}
+}
+
+// executionProgress reports an execution as it runs. On a terminal the spinner
+// shows the running count and failures are printed above it as they land;
+// otherwise each change is printed as a timestamped line, which suits CI logs.
+type executionProgress struct {
+	s           *spinner.Spinner
+	selected    []string
+	interactive bool
+	prev        *model.CliTestPlan
+	last        planwatch.Progress
+}
+
+func newExecutionProgress(s *spinner.Spinner, selected []string) *executionProgress {
+	return &executionProgress{
+		s:           s,
+		selected:    selected,
+		interactive: term.IsTerminal(int(os.Stderr.Fd())),
+	}
+}
+
+// update is called with the plan after every poll.
+func (p *executionProgress) update(plan *model.CliTestPlan) {
+	var failures []model.CliEnrichedTestExecutionResult
+	for _, e := range planwatch.Diff(p.prev, plan) {
+		if e.Kind == planwatch.ResultAdded && e.Result.Status != "SUCCESS" {
+			failures = append(failures, e.Result)
+		}
+	}
+	progress := planwatch.ProgressOf(plan, p.selected)
+	changed := p.prev == nil || progress != p.last
+	p.prev, p.last = plan, progress
+
+	if p.interactive {
+		if len(failures) > 0 {
+			p.s.Stop()
+			for _, r := range failures {
+				style.Error("%s", formatFailureLine(r))
+			}
+			p.s.Start()
+		}
+		p.s.Lock()
+		p.s.Suffix = fmt.Sprintf(" Execution in progress: %s...", progress)
+		p.s.Unlock()
+		return
+	}
+
+	stamp := time.Now().Format(time.RFC3339)
+	for _, r := range failures {
+		fmt.Fprintf(color.Output, "%s Failed %s\n", stamp, formatFailureLine(r))
+	}
+	if changed {
+		fmt.Fprintf(color.Output, "%s Progress %s\n", stamp, progress)
+	}
+}
+
+// latest returns the plan as of the most recent poll, or nil before the first.
+func (p *executionProgress) latest() *model.CliTestPlan {
+	return p.prev
+}
+
+// formatFailureLine is formatResultLine followed by the failure message, if any.
+func formatFailureLine(r model.CliEnrichedTestExecutionResult) string {
+	line := formatResultLine(r)
+	if r.Message != nil && *r.Message != "" {
+		line += ": " + *r.Message
+	}
+	return line
+}
+
+// printDetached prints the plan ID alone on stdout, so scripts can capture it,
+// and how to attach later on stderr.
+func printDetached(planID, what string) {
+	fmt.Println(planID)
+	fmt.Fprintf(os.Stderr, "%s for plan %s. Attach with 'ato test-plans wait %s' or 'ato test-plans watch %s'.\n", what, planID, planID, planID)
+}
+
+// timeoutError is returned by withTimeout when the plan is still running at the
+// timeout, which is not a failure of the plan itself.
+type timeoutError struct {
+	timeout time.Duration
+}
+
+func (e *timeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s", e.timeout)
+}
+
+// printStillRunning reports a plan that outlasted --timeout and how to keep waiting.
+func printStillRunning(planID, status string, timeout time.Duration) {
+	style.Warning("Plan %s is still %s after %s; run 'ato test-plans wait %s' again to keep waiting.", planID, status, timeout, planID)
+}
+
+// withTimeout runs poll, giving up after timeout when it is non-zero. poll is
+// handed a context that is cancelled on timeout, so it stops polling rather than
+// outliving the command (which matters under 'ato shell'). On timeout the latest
+// state of the plan is not known, so nil is returned with a *timeoutError.
+func withTimeout(timeout time.Duration, poll func(ctx context.Context) (*model.CliTestPlan, error)) (*model.CliTestPlan, error) {
+	if timeout <= 0 {
+		return poll(context.Background())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	plan, err := poll(ctx)
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, &timeoutError{timeout: timeout}
+	}
+	return plan, err
 }
 
 func init() {
@@ -75,8 +236,16 @@ func init() {
 	executeCmd.Flags().StringP("tests", "t", "", "A comma-separated list of specific test component IDs to run")
 	executeCmd.Flags().StringP("creds", "c", "", "The name of the credential profile to use (required)")
 
+	executeCmd.Flags().Bool("detach", false, "Start the execution and print the plan ID without waiting for results")
+	executeCmd.Flags().Duration("timeout", 0, "Give up waiting after this long (e.g. 45m); 0 waits indefinitely")
+
+	addExportFlags(executeCmd)
+
 	executeCmd.MarkFlagRequired("planId")
 	executeCmd.MarkFlagRequired("creds")
 
+	executeCmd.RegisterFlagCompletionFunc("planId", completePlanIDs)
+	executeCmd.RegisterFlagCompletionFunc("creds", completeProfiles)
+
 	executeCmd.Flags().SortFlags = false
 }
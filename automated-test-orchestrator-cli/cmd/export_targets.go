@@ -0,0 +1,70 @@
+// automated-test-orchestrator-cli/cmd/export_targets.go
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/export"
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+	"github.com/automated-test-orchestrator/cli-go/internal/style"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// addExportFlags registers the repeatable --export flag and the default --format
+// shared by every command that can export execution results.
+func addExportFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray("export", []string{}, "Export results as 'format=path' (e.g. junit=out/junit.xml), or a bare path using --format; '-' writes to stdout (can be used multiple times)")
+	cmd.Flags().String("format", "json", fmt.Sprintf("Default format for --export values without one (%s)", strings.Join(export.SupportedFormats, ", ")))
+	cmd.RegisterFlagCompletionFunc("format", completeValues(export.SupportedFormats...))
+}
+
+// exportTargetsFromFlags parses the --export flags. If any target streams to
+// stdout, human-readable output is moved to stderr so the data stays clean.
+func exportTargetsFromFlags(cmd *cobra.Command) ([]export.Target, error) {
+	values, _ := cmd.Flags().GetStringArray("export")
+	defaultFormat, _ := cmd.Flags().GetString("format")
+
+	targets, err := export.ParseTargets(values, defaultFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range targets {
+		if export.IsStdout(t.Path) {
+			color.Output = color.Error
+		}
+	}
+	return targets, nil
+}
+
+// writeExports writes the same set of results to every target. All targets are
+// attempted; the first error encountered is returned.
+func writeExports(targets []export.Target, results []model.CliEnrichedTestExecutionResult, credentialProfile string) error {
+	var firstErr error
+	for _, t := range targets {
+		exporter, err := export.NewExporter(t.Format)
+		if err != nil {
+			return err
+		}
+		if xmlExporter, ok := exporter.(*export.XMLExporter); ok {
+			xmlExporter.CredentialProfile = credentialProfile
+		}
+
+		if err := export.ExportToPath(exporter, results, t.Path); err != nil {
+			style.Error("Failed to export %s results to %s. %v", t.Format, t.Path, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		if !export.IsStdout(t.Path) {
+			absPath, _ := filepath.Abs(t.Path)
+			style.Success("Successfully exported %s results to %s", t.Format, absPath)
+		}
+	}
+	return firstErr
+}
@@ -2,19 +2,30 @@
 package cmd
 
 import (
+	"context"
+	encodingcsv "encoding/csv"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/automated-test-orchestrator/cli-go/internal/client"
 	"github.com/automated-test-orchestrator/cli-go/internal/csv"
 	"github.com/automated-test-orchestrator/cli-go/internal/display"
 	"github.com/automated-test-orchestrator/cli-go/internal/errors"
+	"github.com/automated-test-orchestrator/cli-go/internal/export"
+	"github.com/automated-test-orchestrator/cli-go/internal/mappingcheck"
+	"github.com/automated-test-orchestrator/cli-go/internal/mappingfile"
+	"github.com/automated-test-orchestrator/cli-go/internal/mappingmatrix"
+	"github.com/automated-test-orchestrator/cli-go/internal/mappingsync"
 	"github.com/automated-test-orchestrator/cli-go/internal/model"
 	"github.com/automated-test-orchestrator/cli-go/internal/style"
 	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // mappingsCmd represents the mappings command group.
@@ -28,7 +39,7 @@ var mappingsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all existing test mappings",
 	Run: func(cmd *cobra.Command, args []string) {
-		apiClient := client.NewAPIClient(viper.GetString("api_url"))
+		apiClient := newAPIClient()
 		mappings, err := apiClient.GetAllMappings()
 		if err != nil {
 			errors.HandleCLIError(nil, err)
@@ -43,6 +54,45 @@ var mappingsListCmd = &cobra.Command{
 	},
 }
 
+// mappingsGetCmd represents the 'mappings get' command.
+var mappingsGetCmd = &cobra.Command{
+	Use:               "get <mappingId>",
+	Short:             "Get the full details of a test mapping",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: firstArgOnly(completeMappingIDs),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient := newAPIClient()
+		mapping, err := apiClient.GetMapping(args[0])
+		if err != nil {
+			errors.HandleCLIError(nil, err)
+		}
+
+		display.PrintMappingDetails(mapping)
+	},
+}
+
+// mappingsForCmd represents the 'mappings for' command.
+var mappingsForCmd = &cobra.Command{
+	Use:   "for <mainComponentId>",
+	Short: "List the test mappings for a main component",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		mainComponentID := args[0]
+		apiClient := newAPIClient()
+		mappings, err := apiClient.GetMappingsByComponent(mainComponentID)
+		if err != nil {
+			errors.HandleCLIError(nil, err)
+		}
+
+		if len(mappings) == 0 {
+			style.Warning("No mappings found for component %s.", mainComponentID)
+			return
+		}
+
+		display.PrintMappings(mappings)
+	},
+}
+
 // mappingsAddCmd represents the 'mappings add' command.
 var mappingsAddCmd = &cobra.Command{
 	Use:   "add",
@@ -52,7 +102,7 @@ var mappingsAddCmd = &cobra.Command{
 		s.Suffix = " Adding new mapping..."
 		s.Start()
 
-		apiClient := client.NewAPIClient(viper.GetString("api_url"))
+		apiClient := newAPIClient()
 		mainID, _ := cmd.Flags().GetString("mainId")
 		mainName, _ := cmd.Flags().GetString("main-name")
 		testID, _ := cmd.Flags().GetString("testId")
@@ -80,82 +130,770 @@ var mappingsAddCmd = &cobra.Command{
 	},
 }
 
+// mappingsUpdateCmd represents the 'mappings update' command.
+var mappingsUpdateCmd = &cobra.Command{
+	Use:   "update <mappingId>",
+	Short: "Update one or more fields of a test mapping",
+	Long: `Updates an existing mapping in place. Only the flags that are provided are changed;
+all other fields keep their current values.`,
+	Example: `  ato mappings update <mappingId> --test-name "Order Sync Unit Test"
+  ato mappings update <mappingId> --deployed=true --packaged=false`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: firstArgOnly(completeMappingIDs),
+	Run: func(cmd *cobra.Command, args []string) {
+		mappingID := args[0]
+
+		req := model.UpdateMappingRequest{}
+		flags := cmd.Flags()
+		if flags.Changed("main-name") {
+			v, _ := flags.GetString("main-name")
+			req.MainComponentName = &v
+		}
+		if flags.Changed("testId") {
+			v, _ := flags.GetString("testId")
+			req.TestComponentID = &v
+		}
+		if flags.Changed("test-name") {
+			v, _ := flags.GetString("test-name")
+			req.TestComponentName = &v
+		}
+		if flags.Changed("deployed") {
+			v, _ := flags.GetBool("deployed")
+			req.IsDeployed = &v
+		}
+		if flags.Changed("packaged") {
+			v, _ := flags.GetBool("packaged")
+			req.IsPackaged = &v
+		}
+
+		if req == (model.UpdateMappingRequest{}) {
+			style.Warning("Nothing to update. Provide at least one of --main-name, --testId, --test-name, --deployed or --packaged.")
+			return
+		}
+
+		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		s.Suffix = fmt.Sprintf(" Updating mapping %s...", mappingID)
+		s.Start()
+
+		apiClient := newAPIClient()
+		updated, err := apiClient.UpdateMapping(mappingID, req)
+		if err != nil {
+			errors.HandleCLIError(s, err)
+		}
+
+		s.Stop()
+		style.Success("Mapping updated successfully!")
+		display.PrintMappingDetails(updated)
+	},
+}
+
 // mappingsImportCmd represents the 'mappings import' command.
 var mappingsImportCmd = &cobra.Command{
 	Use:   "import",
 	Short: "Bulk import mappings from a CSV file",
-	Run: func(cmd *cobra.Command, args []string) {
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Suffix = " Preparing to import mappings..."
-		s.Start()
+	Long: `Creates a mapping for every row of a CSV file, several at a time. Rows that fail
+to import can be written to a CSV file with an added 'error' column, ready to fix and
+re-import.
 
-		apiClient := client.NewAPIClient(viper.GetString("api_url"))
+Headers are matched case-insensitively and common aliases such as "Main Component ID",
+"test_id" or "packaged" are accepted. Comma, semicolon and tab separated files are
+detected automatically. Without --strict, invalid isDeployed/isPackaged values are
+ignored with a warning and short or long rows are read as far as possible.`,
+	Example: `  ato mappings import --from-csv mappings.csv --parallel 8 --failures-out rejected.csv
+  ato mappings import --from-csv excel-export.csv --strict`,
+	Run: func(cmd *cobra.Command, args []string) {
 		csvPath, _ := cmd.Flags().GetString("from-csv")
+		parallel, _ := cmd.Flags().GetInt("parallel")
+		failuresOut, _ := cmd.Flags().GetString("failures-out")
+		continueOnError, _ := cmd.Flags().GetBool("continue-on-error")
+		strict, _ := cmd.Flags().GetBool("strict")
+		delimiter, _ := cmd.Flags().GetString("delimiter")
+
+		if parallel < 1 {
+			errors.HandleCLIError(nil, fmt.Errorf("--parallel must be at least 1"))
+		}
+		opts := csv.Options{Strict: strict}
+		switch delimiter {
+		case "", "auto":
+		case ",", ";":
+			opts.Delimiter = rune(delimiter[0])
+		case "tab", "\\t", "\t":
+			opts.Delimiter = '\t'
+		default:
+			errors.HandleCLIError(nil, fmt.Errorf("unsupported --delimiter %q: use auto, ',', ';' or tab", delimiter))
+		}
 
 		file, err := os.Open(csvPath)
 		if err != nil {
-			errors.HandleCLIError(s, fmt.Errorf("failed to open file %s: %w", csvPath, err))
+			errors.HandleCLIError(nil, fmt.Errorf("failed to open file %s: %w", csvPath, err))
 		}
 		defer file.Close()
 
-		mappingsToCreate, err := csv.ParseMappingCsv(file)
+		parsed, err := csv.ReadMappingCsv(file, opts)
 		if err != nil {
-			errors.HandleCLIError(s, fmt.Errorf("failed to parse CSV file: %w", err))
+			errors.HandleCLIError(nil, fmt.Errorf("failed to parse CSV file: %w", err))
+		}
+		for _, w := range parsed.Warnings {
+			style.Warning("%s (ignored)", w.Error())
 		}
 
-		if len(mappingsToCreate) == 0 {
-			s.Stop()
+		if len(parsed.Rows) == 0 {
 			style.Warning("No valid mappings found in the CSV file.")
 			return
 		}
-		s.Suffix = fmt.Sprintf(" Found %d mappings to import.", len(mappingsToCreate))
-		time.Sleep(1 * time.Second) // Pause to show message
 
-		var successCount, failureCount int
-		for i, mapping := range mappingsToCreate {
-			s.Suffix = fmt.Sprintf(" Importing mapping %d of %d: %s -> %s", i+1, len(mappingsToCreate), mapping.MainComponentID, mapping.TestComponentID)
-			_, err := apiClient.CreateMapping(mapping)
-			if err != nil {
+		// Rows that could not be parsed are reported alongside rows rejected by the API.
+		rowErrors := make([]error, len(parsed.Rows))
+		var pending []int
+		for i, row := range parsed.Rows {
+			if row.Err != nil {
+				rowErrors[i] = row.Err
+				style.Error("Skipping %v", row.Err)
+				continue
+			}
+			pending = append(pending, i)
+		}
+
+		if !continueOnError && len(pending) < len(parsed.Rows) {
+			writeImportFailures(failuresOut, parsed, rowErrors)
+			style.Error("The CSV file contains invalid rows and --continue-on-error is false. Nothing was imported.")
+			exit(1)
+		}
+
+		apiClient := newAPIClient()
+		bar := style.NewProgressBar(len(pending), "Importing mappings")
+
+		var (
+			mu      sync.Mutex
+			stopped bool
+			wg      sync.WaitGroup
+		)
+		attempted := make([]bool, len(parsed.Rows))
+		sem := make(chan struct{}, parallel)
+
+		for _, i := range pending {
+			mu.Lock()
+			halt := stopped
+			mu.Unlock()
+			if halt {
+				break
+			}
+
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				row := parsed.Rows[i]
+				_, err := apiClient.CreateMapping(*row.Mapping)
+
+				mu.Lock()
+				attempted[i] = true
+				if err != nil {
+					rowErrors[i] = err
+					if !continueOnError {
+						stopped = true
+					}
+				}
+				mu.Unlock()
+
+				if err != nil {
+					// Use the non-terminating formatter for the loop.
+					bar.Printf("%s %s", style.IconCross, style.Red(fmt.Sprintf("Failed to import row %d: %s", row.Line, errors.FormatError(err))))
+				}
+				bar.Increment()
+			}(i)
+		}
+		wg.Wait()
+		bar.Finish()
+
+		var successCount, failureCount, notAttempted int
+		for _, i := range pending {
+			switch {
+			case !attempted[i]:
+				notAttempted++
+			case rowErrors[i] != nil:
 				failureCount++
-				s.Stop()
-				// Use the non-terminating formatter for the loop.
-				errorMsg := errors.FormatError(err)
-				style.Error("Failed to import row %d: %s", i+2, errorMsg)
-				s.Start() // Restart spinner for the next item
-			} else {
+			default:
 				successCount++
 			}
 		}
+		failureCount += len(parsed.Rows) - len(pending)
 
-		s.Stop()
-		style.Header("\n--- Import Complete ---")
+		fmt.Fprintln(color.Output, style.Header("\n--- Import Complete ---"))
 		if successCount > 0 {
 			style.Success("Successfully imported %d mapping(s).", successCount)
 		}
 		if failureCount > 0 {
 			style.Error("Failed to import %d mapping(s). See error details above.", failureCount)
 		}
+		if notAttempted > 0 {
+			style.Warning("Stopped after the first failure; %d mapping(s) were not attempted.", notAttempted)
+		}
+
+		if failureCount > 0 || notAttempted > 0 {
+			writeImportFailures(failuresOut, parsed, rowErrors, attempted...)
+			exit(1)
+		}
 	},
 }
 
-// mappingsRmCmd represents the 'mappings rm' command.
-var mappingsRmCmd = &cobra.Command{
-	Use:   "rm <mappingId>",
-	Short: "Remove a test mapping by its unique ID",
-	Args:  cobra.ExactArgs(1),
+// writeImportFailures writes every failed (or, when attempted is given, never
+// attempted) row to path using the original header plus an 'error' column.
+func writeImportFailures(path string, parsed *csv.MappingCsv, rowErrors []error, attempted ...bool) {
+	if path == "" {
+		return
+	}
+
+	err := export.WriteOutput(path, func(w io.Writer) error {
+		writer := encodingcsv.NewWriter(w)
+		if err := writer.Write(append(append([]string{}, parsed.Header...), "error")); err != nil {
+			return err
+		}
+		for i, row := range parsed.Rows {
+			message := ""
+			switch {
+			case row.Err != nil:
+				message = row.Err.Error()
+			case rowErrors[i] != nil:
+				message = errors.FormatError(rowErrors[i])
+			case len(attempted) > 0 && !attempted[i] && row.Err == nil:
+				message = "not attempted"
+			default:
+				continue
+			}
+			if err := writer.Write(append(append([]string{}, row.Record...), message)); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	})
+	if err != nil {
+		style.Error("Failed to write failures to %s: %v", path, err)
+		return
+	}
+
+	absPath, _ := filepath.Abs(path)
+	style.Info("Rejected rows written to %s", absPath)
+}
+
+// mappingsApplyCmd represents the 'mappings apply' command.
+var mappingsApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Converge the server's mappings on a CSV, YAML or JSON file",
+	Long: `Compares a mapping file against the mappings on the server, keyed on the
+(main component ID, test component ID) pair, and creates or updates mappings so the
+server matches the file. With --prune, mappings that are not in the file are deleted.
+
+The plan is always printed before anything is changed. Use --dry-run to print the
+plan without applying it. Deletions are confirmed before anything is changed;
+use --yes to skip the prompt in scripts.`,
+	Example: `  ato mappings apply -f mappings.yaml --dry-run
+  ato mappings apply -f mappings.csv --prune --yes`,
 	Run: func(cmd *cobra.Command, args []string) {
-		mappingID := args[0]
+		filePath, _ := cmd.Flags().GetString("file")
+		prune, _ := cmd.Flags().GetBool("prune")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		desired, err := mappingfile.ReadFile(filePath)
+		if err != nil {
+			errors.HandleCLIError(nil, err)
+		}
+
 		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Suffix = fmt.Sprintf(" Removing mapping %s...", mappingID)
+		s.Suffix = " Fetching existing mappings..."
 		s.Start()
 
-		apiClient := client.NewAPIClient(viper.GetString("api_url"))
-		if err := apiClient.DeleteMapping(mappingID); err != nil {
+		apiClient := newAPIClient()
+		existing, err := apiClient.GetAllMappings()
+		if err != nil {
 			errors.HandleCLIError(s, err)
 		}
+		s.Stop()
+
+		plan, err := mappingsync.Compute(desired, existing, prune)
+		if err != nil {
+			errors.HandleCLIError(nil, err)
+		}
+
+		display.PrintMappingSyncPlan(plan)
+
+		if !plan.HasChanges() {
+			fmt.Fprintln(color.Output)
+			style.Success("No changes. The server's mappings already match %s.", filePath)
+			return
+		}
+		if dryRun {
+			fmt.Fprintln(color.Output)
+			style.Info("Dry run: no changes were applied.")
+			return
+		}
+		if len(plan.Deletes) > 0 {
+			fmt.Fprintln(color.Output)
+			if !confirmDestructive(cmd, fmt.Sprintf("Apply the plan, permanently removing %d mapping(s)?", len(plan.Deletes))) {
+				style.Warning("Nothing was changed.")
+				return
+			}
+		}
 
+		fmt.Fprintln(color.Output)
+		var failureCount int
+		total := len(plan.Creates) + len(plan.Updates) + len(plan.Deletes)
+		step := 0
+		s.Start()
+
+		reportFailure := func(action string, key mappingsync.Key, err error) {
+			failureCount++
+			s.Stop()
+			style.Error("Failed to %s %s: %s", action, key, errors.FormatError(err))
+			s.Start()
+		}
+
+		for _, c := range plan.Creates {
+			step++
+			s.Suffix = fmt.Sprintf(" [%d/%d] Creating %s", step, total, mappingsync.KeyOfRequest(c))
+			if _, err := apiClient.CreateMapping(c); err != nil {
+				reportFailure("create", mappingsync.KeyOfRequest(c), err)
+			}
+		}
+		for _, u := range plan.Updates {
+			step++
+			s.Suffix = fmt.Sprintf(" [%d/%d] Updating %s", step, total, mappingsync.KeyOf(u.Existing))
+			if _, err := apiClient.UpdateMapping(u.Existing.ID, u.Request); err != nil {
+				reportFailure("update", mappingsync.KeyOf(u.Existing), err)
+			}
+		}
+		for _, d := range plan.Deletes {
+			step++
+			s.Suffix = fmt.Sprintf(" [%d/%d] Deleting %s", step, total, mappingsync.KeyOf(d))
+			if err := apiClient.DeleteMapping(d.ID); err != nil {
+				reportFailure("delete", mappingsync.KeyOf(d), err)
+			}
+		}
 		s.Stop()
-		style.Success("Mapping removed successfully!")
+
+		if failureCount > 0 {
+			style.Error("Apply finished with %d of %d operation(s) failed. Re-run to retry.", failureCount, total)
+			exit(1)
+		}
+		style.Success("Apply complete! %d created, %d updated, %d deleted.", len(plan.Creates), len(plan.Updates), len(plan.Deletes))
+	},
+}
+
+// mappingsExportCmd represents the 'mappings export' command.
+var mappingsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export all mappings to a CSV, YAML or JSON file",
+	Long: `Writes every mapping on the server to a file that 'mappings import' and
+'mappings apply' accept, so mappings can be backed up, edited and re-applied.`,
+	Example: `  ato mappings export -f mappings.csv
+  ato mappings export --format yaml > mappings.yaml`,
+	Run: func(cmd *cobra.Command, args []string) {
+		filePath, _ := cmd.Flags().GetString("file")
+		format, _ := cmd.Flags().GetString("format")
+
+		if format == "" {
+			format = "csv"
+			if !export.IsStdout(filePath) {
+				if inferred, err := mappingfile.FormatFromPath(filePath); err == nil {
+					format = inferred
+				}
+			}
+		}
+		format = strings.ToLower(format)
+		if format == "yml" {
+			format = "yaml"
+		}
+
+		// When streaming to stdout, keep all human-readable output on stderr.
+		if export.IsStdout(filePath) {
+			color.Output = color.Error
+		}
+
+		apiClient := newAPIClient()
+		mappings, err := apiClient.GetAllMappings()
+		if err != nil {
+			errors.HandleCLIError(nil, err)
+		}
+
+		err = export.WriteOutput(filePath, func(w io.Writer) error {
+			return mappingfile.Write(w, mappings, format)
+		})
+		if err != nil {
+			errors.HandleCLIError(nil, fmt.Errorf("failed to export mappings: %w", err))
+		}
+
+		if !export.IsStdout(filePath) {
+			absPath, _ := filepath.Abs(filePath)
+			style.Success("Exported %d mapping(s) to %s", len(mappings), absPath)
+		}
+	},
+}
+
+// mappingsValidateCmd represents the 'mappings validate' command.
+var mappingsValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check mappings for problems before they break an execution",
+	Long: `Checks every mapping for duplicate main/test pairs, self-mappings, missing names
+and test components that are marked as not deployed or not packaged.
+
+When --creds is given, each main and test component is also looked up on the
+integration platform through temporary discovery plans, which are deleted afterwards.
+Missing components and names that no longer match the platform are reported.
+
+Exits with a non-zero status if any ERROR findings are reported.`,
+	Example: `  ato mappings validate
+  ato mappings validate --creds dev-account --report mapping-issues.csv`,
+	Run: func(cmd *cobra.Command, args []string) {
+		creds, _ := cmd.Flags().GetString("creds")
+		reportPath, _ := cmd.Flags().GetString("report")
+
+		// When streaming the report to stdout, keep all human-readable output on stderr.
+		if export.IsStdout(reportPath) {
+			color.Output = color.Error
+		}
+
+		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+		s.Suffix = " Fetching mappings..."
+		s.Start()
+
+		apiClient := newAPIClient()
+		mappings, err := apiClient.GetAllMappings()
+		if err != nil {
+			errors.HandleCLIError(s, err)
+		}
+
+		if len(mappings) == 0 {
+			s.Stop()
+			style.Warning("No mappings found.")
+			return
+		}
+
+		findings := mappingcheck.CheckLocal(mappings)
+
+		if creds != "" {
+			mainIDs, testIDs := uniqueMappingComponentIDs(mappings)
+
+			s.Suffix = fmt.Sprintf(" Resolving %d main component(s) on the integration platform...", len(mainIDs))
+			mainComponents, err := resolveOnPlatform(apiClient, "COMPONENT", mainIDs, creds)
+			if err != nil {
+				s.Stop()
+				style.Warning("Skipped main component checks: %s", errors.FormatError(err))
+				s.Start()
+			}
+
+			s.Suffix = fmt.Sprintf(" Resolving %d test component(s) on the integration platform...", len(testIDs))
+			testComponents, err := resolveOnPlatform(apiClient, "TEST", testIDs, creds)
+			if err != nil {
+				s.Stop()
+				style.Warning("Skipped test component checks: %s", errors.FormatError(err))
+				s.Start()
+			}
+
+			findings = append(findings, mappingcheck.CheckPlatform(mappings, mainComponents, testComponents)...)
+		}
+		s.Stop()
+
+		mappingcheck.Sort(findings)
+		errorCount := mappingcheck.CountErrors(findings)
+
+		if reportPath != "" {
+			err := export.WriteOutput(reportPath, func(w io.Writer) error {
+				return mappingcheck.WriteReport(w, reportPath, findings)
+			})
+			if err != nil {
+				errors.HandleCLIError(nil, fmt.Errorf("failed to write report: %w", err))
+			}
+		}
+
+		if len(findings) == 0 {
+			style.Success("All %d mapping(s) passed validation.", len(mappings))
+		} else {
+			display.PrintMappingFindings(findings)
+			fmt.Fprintln(color.Output)
+			if errorCount > 0 {
+				style.Error("%d error(s) and %d warning(s) found across %d mapping(s).", errorCount, len(findings)-errorCount, len(mappings))
+			} else {
+				style.Warning("%d warning(s) found across %d mapping(s).", len(findings), len(mappings))
+			}
+		}
+		if creds == "" {
+			style.Info("Platform checks were skipped. Use --creds <profile> to verify components on the integration platform.")
+		}
+		if reportPath != "" && !export.IsStdout(reportPath) {
+			absPath, _ := filepath.Abs(reportPath)
+			style.Info("Report written to %s", absPath)
+		}
+
+		if errorCount > 0 {
+			exit(1)
+		}
+	},
+}
+
+// uniqueMappingComponentIDs returns the distinct main and test component IDs.
+func uniqueMappingComponentIDs(mappings []model.CliMapping) (mainIDs, testIDs []string) {
+	seenMain := make(map[string]bool)
+	seenTest := make(map[string]bool)
+	for _, m := range mappings {
+		if !seenMain[m.MainComponentID] {
+			seenMain[m.MainComponentID] = true
+			mainIDs = append(mainIDs, m.MainComponentID)
+		}
+		if !seenTest[m.TestComponentID] {
+			seenTest[m.TestComponentID] = true
+			testIDs = append(testIDs, m.TestComponentID)
+		}
+	}
+	return mainIDs, testIDs
+}
+
+// resolveOnPlatform looks components up on the integration platform by creating
+// a temporary plan of the given type, then deletes the plan. TEST plans only
+// resolve executable processes, which is what a test component must be.
+func resolveOnPlatform(apiClient *client.APIClient, planType string, ids []string, creds string) (map[string]mappingcheck.PlatformComponent, error) {
+	resolved := make(map[string]mappingcheck.PlatformComponent)
+	if len(ids) == 0 {
+		return resolved, nil
+	}
+
+	planName := fmt.Sprintf("ato-validate-%s-%s", strings.ToLower(planType), time.Now().Format("20060102-150405"))
+	planID, err := apiClient.InitiateDiscovery(planName, planType, ids, nil, nil, creds, false)
+	if err != nil {
+		// The API also rejects the plan when none of the IDs resolve, so this is not
+		// necessarily a credentials problem.
+		return nil, fmt.Errorf("could not create a discovery plan (this also happens when none of the components exist): %w", err)
+	}
+	defer apiClient.DeleteTestPlan(planID)
+
+	plan, err := apiClient.PollForPlanCompletion(context.Background(), planID)
+	if err != nil {
+		if plan != nil && plan.FailureReason != nil {
+			return nil, fmt.Errorf("discovery failed: %s", *plan.FailureReason)
+		}
+		return nil, err
+	}
+
+	for _, c := range plan.PlanComponents {
+		info := mappingcheck.PlatformComponent{}
+		if c.ComponentName != nil {
+			info.Name = *c.ComponentName
+		}
+		if c.ComponentType != nil {
+			info.Type = *c.ComponentType
+		}
+		resolved[c.ComponentID] = info
+	}
+	return resolved, nil
+}
+
+// mappingsMatrixCmd represents the 'mappings matrix' command.
+var mappingsMatrixCmd = &cobra.Command{
+	Use:   "matrix",
+	Short: "Show test coverage per component, shared tests and orphaned tests",
+	Long: `Builds a coverage matrix from all mappings and the test plans created within
+the --since window.
+
+  - Main components are grouped by coverage: NONE (seen in a recent plan but with
+    no mappings), SINGLE (one test) or MULTIPLE (several tests).
+  - Test components mapped to --shared-threshold or more main components are shared.
+  - Test components whose main components appear in none of the recent plans are orphans.
+
+The matrix is printed as tables, or written as CSV or JSON with --format and --file.`,
+	Example: `  ato mappings matrix
+  ato mappings matrix --since 180d --shared-threshold 5
+  ato mappings matrix -f test-debt.csv`,
+	Run: func(cmd *cobra.Command, args []string) {
+		since, _ := cmd.Flags().GetString("since")
+		threshold, _ := cmd.Flags().GetInt("shared-threshold")
+		filePath, _ := cmd.Flags().GetString("file")
+		format, _ := cmd.Flags().GetString("format")
+		parallel, _ := cmd.Flags().GetInt("parallel")
+		if parallel < 1 {
+			errors.HandleCLIError(nil, fmt.Errorf("--parallel must be at least 1"))
+		}
+
+		var sinceTime time.Time
+		if since != "" {
+			t, err := parseTimeFlag(since, time.Now())
+			if err != nil {
+				style.Error("%v", err)
+				exit(1)
+			}
+			sinceTime = t
+		}
+
+		if format == "" {
+			format = "table"
+			if filePath != "" {
+				format = export.FormatFromPath(filePath, "json")
+			}
+		}
+		format = strings.ToLower(format)
+		if format != "table" && format != "csv" && format != "json" {
+			style.Error("Unsupported format '%s'. Use one of: table, %s", format, strings.Join(mappingmatrix.SupportedFormats, ", "))
+			exit(1)
+		}
+		if format == "table" && filePath != "" {
+			style.Error("--file requires --format csv or json.")
+			exit(1)
+		}
+		if format != "table" && filePath == "" {
+			filePath = export.StdoutPath
+		}
+
+		// When streaming to stdout, keep all human-readable output on stderr.
+		if export.IsStdout(filePath) {
+			color.Output = color.Error
+		}
+
+		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+		s.Suffix = " Fetching mappings and plans..."
+		s.Start()
+
+		apiClient := newAPIClient()
+		mappings, err := apiClient.GetAllMappings()
+		if err != nil {
+			errors.HandleCLIError(s, err)
+		}
+		summaries, err := apiClient.GetAllPlans()
+		if err != nil {
+			errors.HandleCLIError(s, err)
+		}
+
+		s.Stop()
+
+		var planIDs []string
+		for _, summary := range summaries {
+			if sinceTime.IsZero() || !summary.CreatedAt.Before(sinceTime) {
+				planIDs = append(planIDs, summary.ID)
+			}
+		}
+		plans, err := loadPlans(apiClient, planIDs, parallel)
+		if err != nil {
+			errors.HandleCLIError(nil, err)
+		}
+
+		matrix := mappingmatrix.Build(mappings, plans, threshold)
+		if !sinceTime.IsZero() {
+			matrix.Since = &sinceTime
+		}
+
+		if format == "table" {
+			display.PrintMappingMatrix(matrix)
+			return
+		}
+
+		err = export.WriteOutput(filePath, func(w io.Writer) error {
+			return mappingmatrix.Write(w, matrix, format)
+		})
+		if err != nil {
+			errors.HandleCLIError(nil, fmt.Errorf("failed to write matrix: %w", err))
+		}
+		if !export.IsStdout(filePath) {
+			absPath, _ := filepath.Abs(filePath)
+			style.Success("Matrix for %d main and %d test component(s) written to %s", matrix.Summary.MainComponents, matrix.Summary.TestComponents, absPath)
+		}
+	},
+}
+
+// loadPlans fetches the details of each plan, parallel at a time, behind a
+// progress bar. It stops starting new requests after the first error.
+func loadPlans(apiClient *client.APIClient, planIDs []string, parallel int) ([]*model.CliTestPlan, error) {
+	if len(planIDs) == 0 {
+		return nil, nil
+	}
+
+	bar := style.NewProgressBar(len(planIDs), "Loading plans")
+	plans := make([]*model.CliTestPlan, len(planIDs))
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	sem := make(chan struct{}, parallel)
+
+	for i, id := range planIDs {
+		mu.Lock()
+		halt := firstErr != nil
+		mu.Unlock()
+		if halt {
+			break
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			plan, err := apiClient.GetPlanStatus(id)
+
+			mu.Lock()
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+			plans[i] = plan
+			mu.Unlock()
+			bar.Increment()
+		}(i, id)
+	}
+	wg.Wait()
+	bar.Finish()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return plans, nil
+}
+
+// mappingsRmCmd represents the 'mappings rm' command.
+var mappingsRmCmd = &cobra.Command{
+	Use:   "rm <mappingId...>",
+	Short: "Remove test mappings by their unique IDs",
+	Long: `Removes one or more test mappings. The mappings are shown before asking for
+confirmation; use --yes to skip the prompt in scripts.`,
+	Example: `  ato mappings rm 1a2b3c4d-...
+  ato mappings rm 1a2b3c4d-... 5e6f7a8b-... --yes`,
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: completeMappingIDs,
+	Run: func(cmd *cobra.Command, args []string) {
+		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+		s.Suffix = " Fetching mappings..."
+		s.Start()
+
+		apiClient := newAPIClient()
+		var mappings []model.CliMapping
+		for _, id := range args {
+			mapping, err := apiClient.GetMapping(id)
+			if err != nil {
+				errors.HandleCLIError(s, err)
+			}
+			mappings = append(mappings, *mapping)
+		}
+		s.Stop()
+
+		display.PrintMappings(mappings)
+		if !confirmDestructive(cmd, fmt.Sprintf("Permanently remove %d mapping(s)?", len(mappings))) {
+			style.Warning("Nothing was removed.")
+			return
+		}
+
+		var failed int
+		for _, m := range mappings {
+			if err := apiClient.DeleteMapping(m.ID); err != nil {
+				failed++
+				style.Error("Failed to remove mapping %s: %s", m.ID, errors.FormatError(err))
+				continue
+			}
+			style.Success("Mapping %s removed successfully!", m.ID)
+		}
+		if failed > 0 {
+			exit(1)
+		}
 	},
 }
 
@@ -165,6 +903,10 @@ func init() {
 	// List command
 	mappingsCmd.AddCommand(mappingsListCmd)
 
+	// Get and lookup commands
+	mappingsCmd.AddCommand(mappingsGetCmd)
+	mappingsCmd.AddCommand(mappingsForCmd)
+
 	// Add command with flags
 	mappingsCmd.AddCommand(mappingsAddCmd)
 	mappingsAddCmd.Flags().String("mainId", "", "The main component ID (required)")
@@ -174,11 +916,60 @@ func init() {
 	mappingsAddCmd.MarkFlagRequired("mainId")
 	mappingsAddCmd.MarkFlagRequired("testId")
 
+	// Update command with flags
+	mappingsCmd.AddCommand(mappingsUpdateCmd)
+	mappingsUpdateCmd.Flags().String("main-name", "", "A new descriptive name for the main component")
+	mappingsUpdateCmd.Flags().String("testId", "", "A new test component ID")
+	mappingsUpdateCmd.Flags().String("test-name", "", "A new descriptive name for the test component")
+	mappingsUpdateCmd.Flags().Bool("deployed", false, "Whether the test component is deployed")
+	mappingsUpdateCmd.Flags().Bool("packaged", false, "Whether the test component is packaged")
+	mappingsUpdateCmd.Flags().SortFlags = false
+
 	// Import command with flags
 	mappingsCmd.AddCommand(mappingsImportCmd)
 	mappingsImportCmd.Flags().String("from-csv", "", "Path to a CSV file for bulk import (required)")
+	mappingsImportCmd.Flags().Int("parallel", 4, "Number of mappings to import concurrently")
+	mappingsImportCmd.Flags().String("failures-out", "", "Path to write rejected rows to, with an added 'error' column")
+	mappingsImportCmd.Flags().Bool("continue-on-error", true, "Keep importing after a row fails; set to false to stop at the first failure")
+	mappingsImportCmd.Flags().Bool("strict", false, "Reject rows with invalid booleans or the wrong number of fields instead of ignoring those values")
+	mappingsImportCmd.Flags().String("delimiter", "auto", "Field separator: auto, ',', ';' or tab")
 	mappingsImportCmd.MarkFlagRequired("from-csv")
+	mappingsImportCmd.Flags().SortFlags = false
+
+	// Apply command with flags
+	mappingsCmd.AddCommand(mappingsApplyCmd)
+	mappingsApplyCmd.Flags().StringP("file", "f", "", "Path to a .csv, .yaml or .json mapping file (required)")
+	mappingsApplyCmd.Flags().Bool("prune", false, "Delete mappings on the server that are not in the file")
+	mappingsApplyCmd.Flags().Bool("dry-run", false, "Print the plan without applying it")
+	addYesFlag(mappingsApplyCmd)
+	mappingsApplyCmd.MarkFlagRequired("file")
+	mappingsApplyCmd.Flags().SortFlags = false
+
+	// Export command with flags
+	mappingsCmd.AddCommand(mappingsExportCmd)
+	mappingsExportCmd.Flags().StringP("file", "f", export.StdoutPath, "Path to write the mappings to, or '-' for stdout")
+	mappingsExportCmd.Flags().String("format", "", fmt.Sprintf("Format of the export (%s); inferred from the file extension if omitted, otherwise csv", strings.Join(mappingfile.SupportedFormats, ", ")))
+	mappingsExportCmd.RegisterFlagCompletionFunc("format", completeValues(mappingfile.SupportedFormats...))
+	mappingsExportCmd.Flags().SortFlags = false
+
+	// Validate command with flags
+	mappingsCmd.AddCommand(mappingsValidateCmd)
+	mappingsValidateCmd.Flags().StringP("creds", "c", "", "Credential profile used to verify components on the integration platform")
+	mappingsValidateCmd.Flags().String("report", "", "Path to write the findings to (.csv or .json), or '-' for JSON on stdout")
+	mappingsValidateCmd.RegisterFlagCompletionFunc("creds", completeProfiles)
+	mappingsValidateCmd.Flags().SortFlags = false
+
+	// Matrix command with flags
+	mappingsCmd.AddCommand(mappingsMatrixCmd)
+	mappingsMatrixCmd.Flags().String("since", "90d", "Only consider plans created after this time (e.g. 30d, 2w or 2024-01-31); empty for all plans")
+	mappingsMatrixCmd.Flags().Int("shared-threshold", 3, "Number of main components at which a test component counts as shared")
+	mappingsMatrixCmd.Flags().String("format", "", "Output format (table, csv, json); inferred from --file if omitted")
+	mappingsMatrixCmd.Flags().StringP("file", "f", "", "Path to write the matrix to, or '-' for stdout")
+	mappingsMatrixCmd.Flags().Int("parallel", 4, "Number of plans to load concurrently")
+	mappingsMatrixCmd.RegisterFlagCompletionFunc("format", completeValues(append([]string{"table"}, mappingmatrix.SupportedFormats...)...))
+	mappingsMatrixCmd.Flags().SortFlags = false
 
 	// Remove command
 	mappingsCmd.AddCommand(mappingsRmCmd)
+	addYesFlag(mappingsRmCmd)
 }
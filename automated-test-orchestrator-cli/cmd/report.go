@@ -0,0 +1,70 @@
+// automated-test-orchestrator-cli/cmd/report.go
+package cmd
+
+import (
+	"github.com/automated-test-orchestrator/cli-go/internal/display"
+	"github.com/automated-test-orchestrator/cli-go/internal/export"
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+	"github.com/automated-test-orchestrator/cli-go/internal/style"
+	"github.com/spf13/cobra"
+)
+
+// reportCmd represents the report command.
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Render a report from previously exported JSON results",
+	Long: `Loads one or more files written by 'ato results --export json=...' and renders them
+without contacting the API. Multiple --from files are merged into a single report.
+
+With no export flags the results are printed to the terminal. Use --format on its own
+to write a rendered report to stdout, or --export format=path to write files.`,
+	Example: `  ato report --from results.json
+  ato report --from a.json --from b.json --format markdown
+  ato report --from nightly.json --export junit=out/junit.xml --export html=out/report.html`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		fromPaths, _ := cmd.Flags().GetStringArray("from")
+
+		// A bare --format renders to stdout.
+		if cmd.Flags().Changed("format") && !cmd.Flags().Changed("export") {
+			cmd.Flags().Set("export", export.StdoutPath)
+		}
+
+		exportTargets, err := exportTargetsFromFlags(cmd)
+		if err != nil {
+			style.Error("%v", err)
+			exit(1)
+		}
+
+		var sets [][]model.CliEnrichedTestExecutionResult
+		for _, path := range fromPaths {
+			results, err := export.LoadJSONResults(path)
+			if err != nil {
+				style.Error("Failed to load results. %v", err)
+				exit(1)
+			}
+			sets = append(sets, results)
+		}
+		results := export.MergeResults(sets...)
+
+		if len(exportTargets) > 0 {
+			if err := writeExports(exportTargets, results, ""); err != nil {
+				exit(1)
+			}
+			return
+		}
+
+		display.PrintVerboseResults(results, "")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+
+	reportCmd.Flags().StringArray("from", []string{}, "Path to a JSON results file to load, or '-' for stdin (required, can be used multiple times)")
+	addExportFlags(reportCmd)
+
+	reportCmd.MarkFlagRequired("from")
+
+	reportCmd.Flags().SortFlags = false
+}
@@ -2,23 +2,46 @@
 package cmd
 
 import (
-	"os"
-	"path/filepath"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
-	"github.com/automated-test-orchestrator/cli-go/internal/client"
 	"github.com/automated-test-orchestrator/cli-go/internal/display"
-	"github.com/automated-test-orchestrator/cli-go/internal/export"
 	"github.com/automated-test-orchestrator/cli-go/internal/model"
+	"github.com/automated-test-orchestrator/cli-go/internal/pattern"
+	"github.com/automated-test-orchestrator/cli-go/internal/resultquery"
 	"github.com/automated-test-orchestrator/cli-go/internal/style"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // resultsCmd represents the results command.
 var resultsCmd = &cobra.Command{
 	Use:   "results",
 	Short: "Query for test execution results with optional filters",
+	Long: `Queries test execution results. The ID and status filters are applied by the API;
+the time, name, test case and text filters are applied to the results it returns.
+
+--plan-name and --test-name match a substring, a glob when they contain * or ?, or
+a regular expression when wrapped in slashes. --grep is a regular expression matched
+against result messages and test case details. With --case-status or --grep, only
+the matching test cases of each result are shown and exported. --case-status FAILED
+also keeps process-level failures that produced no test cases.`,
+	Example: `  ato results --since 7d --case-status FAILED --grep timeout --export failures.xml
+  ato results --plan-name 'nightly-*' --test-name '/order.*sync/' --until 2024-02-01`,
 	Run: func(cmd *cobra.Command, args []string) {
+		exportTargets, err := exportTargetsFromFlags(cmd)
+		if err != nil {
+			style.Error("%v", err)
+			exit(1)
+		}
+
+		resultFilter, err := resultFilterFromFlags(cmd)
+		if err != nil {
+			style.Error("%v", err)
+			exit(1)
+		}
+
 		style.Info("Fetching test execution results...")
 
 		// Collect filter values from flags
@@ -30,37 +53,24 @@ var resultsCmd = &cobra.Command{
 		}
 		verbose, _ := cmd.Flags().GetBool("verbose")
 
-		apiClient := client.NewAPIClient(viper.GetString("api_url"))
+		apiClient := newAPIClient()
 		results, err := apiClient.GetExecutionResults(filters)
 		if err != nil {
 			style.Error("Failed to fetch results. %v", err)
-			os.Exit(1)
+			exit(1)
 		}
 
+		results = resultFilter.Apply(results)
 		if len(results) == 0 {
 			style.Warning("No test execution results found matching the specified criteria.")
 			return
 		}
 
 		// Handle Export
-		exportPath, _ := cmd.Flags().GetString("export")
-		exportFormat, _ := cmd.Flags().GetString("format")
-
-		if exportPath != "" {
-			exporter, err := export.NewExporter(exportFormat)
-			if err != nil {
-				style.Error("%v", err)
-				os.Exit(1)
-			}
-
-			err = exporter.Export(results, exportPath)
-			if err != nil {
-				style.Error("Failed to export results. %v", err)
-				os.Exit(1)
+		if len(exportTargets) > 0 {
+			if err := writeExports(exportTargets, results, ""); err != nil {
+				exit(1)
 			}
-
-			absPath, _ := filepath.Abs(exportPath)
-			style.Success("Successfully exported results to %s", absPath)
 			return
 		}
 
@@ -72,6 +82,57 @@ var resultsCmd = &cobra.Command{
 	},
 }
 
+// resultFilterFromFlags builds the client-side result filter from the command's flags.
+func resultFilterFromFlags(cmd *cobra.Command) (resultquery.Filter, error) {
+	var f resultquery.Filter
+	flags := cmd.Flags()
+	now := time.Now()
+
+	if v, _ := flags.GetString("since"); v != "" {
+		t, err := parseTimeFlag(v, now)
+		if err != nil {
+			return f, fmt.Errorf("invalid --since: %w", err)
+		}
+		f.Since = t
+	}
+	if v, _ := flags.GetString("until"); v != "" {
+		t, err := parseTimeFlag(v, now)
+		if err != nil {
+			return f, fmt.Errorf("invalid --until: %w", err)
+		}
+		f.Until = t
+	}
+	if v, _ := flags.GetString("plan-name"); v != "" {
+		re, err := pattern.Compile(v)
+		if err != nil {
+			return f, fmt.Errorf("invalid --plan-name: %w", err)
+		}
+		f.PlanName = re
+	}
+	if v, _ := flags.GetString("test-name"); v != "" {
+		re, err := pattern.Compile(v)
+		if err != nil {
+			return f, fmt.Errorf("invalid --test-name: %w", err)
+		}
+		f.TestName = re
+	}
+	if v, _ := flags.GetString("case-status"); v != "" {
+		v = strings.ToUpper(v)
+		if v != "PASSED" && v != "FAILED" {
+			return f, fmt.Errorf("invalid --case-status %q: use PASSED or FAILED", v)
+		}
+		f.CaseStatus = v
+	}
+	if v, _ := flags.GetString("grep"); v != "" {
+		re, err := regexp.Compile("(?i)" + v)
+		if err != nil {
+			return f, fmt.Errorf("invalid --grep expression: %w", err)
+		}
+		f.Grep = re
+	}
+	return f, nil
+}
+
 func init() {
 	rootCmd.AddCommand(resultsCmd)
 
@@ -79,11 +140,19 @@ func init() {
 	resultsCmd.Flags().String("componentId", "", "Filter results by a specific Discovered Component ID")
 	resultsCmd.Flags().String("testId", "", "Filter results by a specific Test Component ID")
 	resultsCmd.Flags().String("status", "", "Filter results by status (SUCCESS or FAILURE)")
+	resultsCmd.Flags().String("since", "", "Only results executed after this time (e.g. 7d, 2024-01-31 or RFC 3339)")
+	resultsCmd.Flags().String("until", "", "Only results executed before this time (e.g. 1d, 2024-02-01 or RFC 3339)")
+	resultsCmd.Flags().String("plan-name", "", "Only results from plans whose name matches: substring, glob or /regex/")
+	resultsCmd.Flags().String("test-name", "", "Only results from tests whose name matches: substring, glob or /regex/")
+	resultsCmd.Flags().String("case-status", "", "Only test cases with this status (PASSED or FAILED)")
+	resultsCmd.Flags().String("grep", "", "Only results whose message or test case details match this regular expression")
 	resultsCmd.Flags().BoolP("verbose", "v", false, "Display a detailed report of failed tests and their error messages")
+	resultsCmd.RegisterFlagCompletionFunc("planId", completePlanIDs)
+	resultsCmd.RegisterFlagCompletionFunc("status", completeValues("SUCCESS", "FAILURE"))
+	resultsCmd.RegisterFlagCompletionFunc("case-status", completeValues("PASSED", "FAILED"))
 
 	// Export Flags
-	resultsCmd.Flags().String("export", "", "Path to export the results to a file")
-	resultsCmd.Flags().String("format", "json", "Format of the export file (json, csv, xml)")
+	addExportFlags(resultsCmd)
 
 	resultsCmd.Flags().SortFlags = false
 }
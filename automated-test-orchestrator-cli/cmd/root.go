@@ -5,6 +5,8 @@ import (
 	"os"
 	"strings"
 
+	"github.com/automated-test-orchestrator/cli-go/internal/client"
+	"github.com/automated-test-orchestrator/cli-go/internal/errors"
 	"github.com/automated-test-orchestrator/cli-go/internal/style"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -22,10 +24,15 @@ var rootCmd = &cobra.Command{
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		style.Error("%v", err)
-		os.Exit(1)
+		exit(1)
 	}
 }
 
+// exit ends the command with the given status; see errors.Exit.
+func exit(code int) {
+	errors.Exit(code)
+}
+
 func init() {
 	// Force color output on Windows
 	color.NoColor = false
@@ -61,3 +68,16 @@ func initConfig() {
 	// We ignore the error if the file doesn't exist, as it will be created on 'config set'.
 	_ = viper.ReadInConfig()
 }
+
+// sharedClient, when set, is returned by newAPIClient instead of a new client.
+// 'ato shell' sets it so every command in a session reuses one client.
+var sharedClient *client.APIClient
+
+// newAPIClient returns a client for the configured API URL.
+func newAPIClient() *client.APIClient {
+	apiURL := viper.GetString("api_url")
+	if sharedClient != nil && sharedClient.BaseURL == apiURL {
+		return sharedClient
+	}
+	return client.NewAPIClient(apiURL)
+}
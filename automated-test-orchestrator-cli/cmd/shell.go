@@ -0,0 +1,426 @@
+// automated-test-orchestrator-cli/cmd/shell.go
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/errors"
+	"github.com/automated-test-orchestrator/cli-go/internal/style"
+	"github.com/chzyer/readline"
+	"github.com/fatih/color"
+	"github.com/kballard/go-shellquote"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const shellHelp = `Shell commands:
+  use <planId>      Make a plan the current plan ('use -' clears it)
+  profile <name>    Pass --creds <name> to commands that take it ('profile -' clears it)
+  status            Show the current plan, profile and API URL
+  exit, quit        Leave the shell (or press Ctrl+D)
+
+Any other line runs as an ato command, e.g. 'test-plans list' or 'execute'. With a
+current plan, 'execute', 'results' and 'test-plans get/watch/wait' default to it.
+Discovering, executing or opening a plan makes it the current plan.`
+
+// shellCmd represents the shell command.
+var shellCmd = &cobra.Command{
+	Use:   "shell",
+	Short: "Start an interactive shell for running ato commands",
+	Long: `Starts a REPL that runs each line as an ato command, sharing one API client and
+the --api-url and --creds given to the shell. The shell remembers a current plan so
+follow-up commands don't need its ID, keeps history in ~/.ato_history and completes
+commands, flags, plan IDs, credential profiles and mapping IDs with Tab, like
+'ato completion' does.
+
+A failing command reports its error and returns to the prompt. Ctrl+C while a
+command is running ends the shell.
+
+` + shellHelp,
+	Example: `  ato shell --creds dev
+  ato> discover -i 1a2b3c4d
+  ato [plan 5f0e2a91 | dev]> execute
+  ato [plan 5f0e2a91 | dev]> results --status FAILURE`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		creds, _ := cmd.Flags().GetString("creds")
+
+		home, err := os.UserHomeDir()
+		if err != nil {
+			style.Error("Could not find the home directory for shell history. %v", err)
+			exit(1)
+		}
+
+		session = &shellSession{
+			profile: creds,
+			apiURL:  viper.GetString("api_url"),
+		}
+		session.apiURLFlag = rootCmd.PersistentFlags().Changed("api-url")
+		sharedClient = newAPIClient()
+
+		rl, err := readline.NewEx(&readline.Config{
+			Prompt:            session.prompt(),
+			HistoryFile:       filepath.Join(home, ".ato_history"),
+			HistorySearchFold: true,
+			AutoComplete:      &shellCompleter{},
+			InterruptPrompt:   "^C",
+			EOFPrompt:         "exit",
+		})
+		if err != nil {
+			style.Error("Failed to start the shell. %v", err)
+			exit(1)
+		}
+		defer rl.Close()
+
+		style.Info("Connected to %s. Type 'help' for shell commands, 'exit' to leave.", session.apiURL)
+		for {
+			line, err := rl.Readline()
+			if err == readline.ErrInterrupt {
+				continue
+			}
+			if err == io.EOF {
+				return
+			}
+
+			args, err := shellquote.Split(strings.TrimSpace(line))
+			if err != nil {
+				style.Error("%v", err)
+				continue
+			}
+			if len(args) > 0 && args[0] == "ato" {
+				args = args[1:]
+			}
+			if len(args) == 0 {
+				continue
+			}
+
+			if done := session.run(args); done {
+				return
+			}
+			rl.SetPrompt(session.prompt())
+		}
+	},
+}
+
+// session is the running shell, or nil outside 'ato shell'.
+var session *shellSession
+
+// shellSession is the state kept between the lines of a shell.
+type shellSession struct {
+	plan       string // The current plan
+	profile    string // Passed as --creds when a command takes it and it isn't given
+	apiURL     string
+	apiURLFlag bool // Whether --api-url was given to the shell, so it is restored after each line
+}
+
+// shellExit is panicked by errors.Exit inside the shell so the failing command
+// unwinds back to the prompt instead of ending the process.
+type shellExit int
+
+// rememberPlan makes a plan the shell's current plan. It does nothing outside the shell.
+func rememberPlan(planID string) {
+	if session != nil && planID != "" {
+		session.plan = planID
+	}
+}
+
+func (s *shellSession) prompt() string {
+	var parts []string
+	if s.plan != "" {
+		parts = append(parts, "plan "+shortID(s.plan))
+	}
+	if s.profile != "" {
+		parts = append(parts, s.profile)
+	}
+	if len(parts) == 0 {
+		return "ato> "
+	}
+	return fmt.Sprintf("ato [%s]> ", strings.Join(parts, " | "))
+}
+
+// run handles one line, returning true when the shell should end.
+func (s *shellSession) run(args []string) bool {
+	switch args[0] {
+	case "exit", "quit":
+		return true
+	case "help":
+		if len(args) == 1 {
+			fmt.Println(shellHelp)
+			fmt.Println()
+		}
+	case "status":
+		style.PrintKV("API URL", s.apiURL)
+		style.PrintKV("Current plan", orNone(s.plan))
+		style.PrintKV("Profile", orNone(s.profile))
+		return false
+	case "use":
+		s.plan = builtinArg(args, s.plan)
+		return false
+	case "profile":
+		s.profile = builtinArg(args, s.profile)
+		return false
+	case "shell":
+		style.Error("Already in a shell.")
+		return false
+	}
+
+	s.execute(s.withDefaults(args))
+	return false
+}
+
+// execute runs a line through the command tree, recovering from the exit of a
+// failing command.
+func (s *shellSession) execute(args []string) {
+	output := color.Output
+	exitFunc := errors.Exit
+	defer func() {
+		color.Output = output // Undo an export to stdout moving output to stderr.
+		errors.Exit = exitFunc
+		if r := recover(); r != nil {
+			if _, ok := r.(shellExit); !ok {
+				panic(r)
+			}
+		}
+	}()
+	errors.Exit = func(code int) { panic(shellExit(code)) }
+
+	s.resetFlags()
+	rootCmd.SetArgs(args)
+	if err := rootCmd.Execute(); err != nil {
+		style.Error("%v", err)
+	}
+}
+
+// withDefaults adds the current plan and profile to a command line that
+// doesn't give them. The flags are inserted right after the command path.
+func (s *shellSession) withDefaults(args []string) []string {
+	cmd, rest, err := rootCmd.Find(args)
+	if err != nil || cmd == rootCmd {
+		return args
+	}
+	s.resetFlags()
+	defer s.resetFlags()
+	if err := cmd.ParseFlags(rest); err != nil {
+		return args // Let the command report it.
+	}
+
+	var extra []string
+	if s.plan != "" {
+		if f := cmd.Flags().Lookup("planId"); f != nil && !f.Changed {
+			extra = append(extra, "--planId", s.plan)
+		}
+		if takesPlanArg(cmd) && len(cmd.Flags().Args()) == 0 {
+			extra = append(extra, s.plan)
+		}
+	}
+	if s.profile != "" {
+		if f := cmd.Flags().Lookup("creds"); f != nil && !f.Changed {
+			extra = append(extra, "--creds", s.profile)
+		}
+	}
+	if len(extra) == 0 {
+		return args
+	}
+
+	path := len(args) - len(rest)
+	out := append([]string{}, args[:path]...)
+	out = append(out, extra...)
+	return append(out, args[path:]...)
+}
+
+// takesPlanArg reports whether a command's positional argument is a plan ID
+// that defaults to the current plan.
+func takesPlanArg(cmd *cobra.Command) bool {
+	return cmd == testPlansGetCmd || cmd == testPlansWatchCmd || cmd == testPlansWaitCmd
+}
+
+// resetFlags puts every flag back to its default, because the commands are
+// package-level and keep their flag values from one line to the next.
+func (s *shellSession) resetFlags() {
+	var reset func(c *cobra.Command)
+	reset = func(c *cobra.Command) {
+		c.NonInheritedFlags().VisitAll(func(f *pflag.Flag) {
+			if sv, ok := f.Value.(pflag.SliceValue); ok {
+				sv.Replace(splitDefault(f.DefValue))
+			} else {
+				f.Value.Set(f.DefValue)
+			}
+			f.Changed = false
+		})
+		for _, sub := range c.Commands() {
+			reset(sub)
+		}
+	}
+	reset(rootCmd)
+
+	if s.apiURLFlag {
+		rootCmd.PersistentFlags().Set("api-url", s.apiURL)
+	}
+}
+
+// splitDefault parses the "[a,b]" default of a slice flag.
+func splitDefault(value string) []string {
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+	if value == "" {
+		return []string{}
+	}
+	return strings.Split(value, ",")
+}
+
+// builtinArg returns the argument of 'use' or 'profile': "-" clears the value,
+// and no argument prints it.
+func builtinArg(args []string, current string) string {
+	if len(args) < 2 {
+		fmt.Println(orNone(current))
+		return current
+	}
+	if args[1] == "-" {
+		return ""
+	}
+	return args[1]
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
+
+// shortID abbreviates a UUID to its first block.
+func shortID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
+
+// shellCompleter completes shell lines with readline, using the same
+// completion hooks as 'ato completion'.
+type shellCompleter struct{}
+
+// Do implements readline.AutoCompleter.
+func (c *shellCompleter) Do(line []rune, pos int) ([][]rune, int) {
+	text := string(line[:pos])
+	words := strings.Fields(text)
+	current := ""
+	if len(words) > 0 && !strings.HasSuffix(text, " ") {
+		current = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+	if len(words) > 0 && words[0] == "ato" {
+		words = words[1:]
+	}
+
+	candidates, directive := c.candidates(words, current)
+	suffix := " "
+	if directive&cobra.ShellCompDirectiveNoSpace != 0 {
+		suffix = ""
+	}
+	var matches [][]rune
+	for _, candidate := range candidates {
+		value, _, _ := strings.Cut(candidate, "\t") // Drop the description.
+		if strings.HasPrefix(value, current) {
+			matches = append(matches, []rune(value[len(current):]+suffix))
+		}
+	}
+	return matches, len([]rune(current))
+}
+
+// candidates returns every value that could follow words.
+func (c *shellCompleter) candidates(words []string, current string) ([]string, cobra.ShellCompDirective) {
+	if len(words) == 0 {
+		names := []string{"use", "profile", "status", "exit"}
+		for _, sub := range rootCmd.Commands() {
+			if sub.IsAvailableCommand() && sub != shellCmd {
+				names = append(names, sub.Name())
+			}
+		}
+		return names, cobra.ShellCompDirectiveDefault
+	}
+	switch words[0] {
+	case "use":
+		return completePlanIDs(nil, nil, current)
+	case "profile":
+		return completeProfiles(nil, nil, current)
+	}
+
+	cmd, rest, err := rootCmd.Find(words)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+	args := positionalArgs(cmd, rest)
+
+	if strings.HasPrefix(current, "-") {
+		var names []string
+		addFlag := func(f *pflag.Flag) {
+			if !f.Hidden {
+				names = append(names, "--"+f.Name)
+			}
+		}
+		cmd.Flags().VisitAll(addFlag)
+		cmd.InheritedFlags().VisitAll(addFlag)
+		return names, cobra.ShellCompDirectiveDefault
+	}
+
+	if prev := words[len(words)-1]; strings.HasPrefix(prev, "-") && !strings.Contains(prev, "=") {
+		if f := lookupFlag(cmd, prev); f != nil && f.Value.Type() != "bool" {
+			if complete, ok := cmd.GetFlagCompletionFunc(f.Name); ok {
+				return complete(cmd, args, current)
+			}
+			return nil, cobra.ShellCompDirectiveDefault
+		}
+	}
+
+	if cmd.HasAvailableSubCommands() && len(rest) == 0 {
+		var names []string
+		for _, sub := range cmd.Commands() {
+			if sub.IsAvailableCommand() {
+				names = append(names, sub.Name())
+			}
+		}
+		return names, cobra.ShellCompDirectiveDefault
+	}
+	if cmd.ValidArgsFunction != nil {
+		return cmd.ValidArgsFunction(cmd, args, current)
+	}
+	return nil, cobra.ShellCompDirectiveDefault
+}
+
+// positionalArgs drops the flags and their values from args.
+func positionalArgs(cmd *cobra.Command, args []string) []string {
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			positional = append(positional, arg)
+			continue
+		}
+		if f := lookupFlag(cmd, arg); f != nil && f.Value.Type() != "bool" && !strings.Contains(arg, "=") {
+			i++ // Skip the flag's value.
+		}
+	}
+	return positional
+}
+
+// lookupFlag finds a flag of cmd by its "--name" or "-n" spelling.
+func lookupFlag(cmd *cobra.Command, arg string) *pflag.Flag {
+	if name, ok := strings.CutPrefix(arg, "--"); ok {
+		return cmd.Flags().Lookup(name)
+	}
+	if len(arg) == 2 {
+		return cmd.Flags().ShorthandLookup(arg[1:])
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(shellCmd)
+	shellCmd.Flags().StringP("creds", "c", "", "Credential profile passed as --creds to commands that take it")
+	shellCmd.RegisterFlagCompletionFunc("creds", completeProfiles)
+}
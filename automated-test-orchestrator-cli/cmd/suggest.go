@@ -0,0 +1,65 @@
+// automated-test-orchestrator-cli/cmd/suggest.go
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/client"
+)
+
+// suggestion is a value offered for completion, with a short description of it.
+type suggestion struct {
+	Value       string `json:"value"`
+	Description string `json:"description"`
+}
+
+// planSuggestions lists plan IDs, newest first, described by name and status.
+func planSuggestions(apiClient *client.APIClient) ([]suggestion, error) {
+	plans, err := apiClient.GetAllPlans()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(plans, func(i, j int) bool { return plans[i].CreatedAt.After(plans[j].CreatedAt) })
+
+	suggestions := make([]suggestion, 0, len(plans))
+	for _, p := range plans {
+		suggestions = append(suggestions, suggestion{Value: p.ID, Description: fmt.Sprintf("%s (%s)", p.Name, p.Status)})
+	}
+	return suggestions, nil
+}
+
+// profileSuggestions lists credential profile names, described by account.
+func profileSuggestions(apiClient *client.APIClient) ([]suggestion, error) {
+	profiles, err := apiClient.ListCredentialProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]suggestion, 0, len(profiles))
+	for _, p := range profiles {
+		suggestions = append(suggestions, suggestion{Value: p.ProfileName, Description: fmt.Sprintf("%s on %s", p.Credentials.Username, p.Credentials.AccountID)})
+	}
+	return suggestions, nil
+}
+
+// mappingSuggestions lists mapping IDs, described as "main -> test".
+func mappingSuggestions(apiClient *client.APIClient) ([]suggestion, error) {
+	mappings, err := apiClient.GetAllMappings()
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]suggestion, 0, len(mappings))
+	for _, m := range mappings {
+		main, test := m.MainComponentID, m.TestComponentID
+		if m.MainComponentName != nil && *m.MainComponentName != "" {
+			main = *m.MainComponentName
+		}
+		if m.TestComponentName != nil && *m.TestComponentName != "" {
+			test = *m.TestComponentName
+		}
+		suggestions = append(suggestions, suggestion{Value: m.ID, Description: fmt.Sprintf("%s -> %s", main, test)})
+	}
+	return suggestions, nil
+}
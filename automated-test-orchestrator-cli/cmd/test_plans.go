@@ -3,16 +3,21 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
-	"github.com/automated-test-orchestrator/cli-go/internal/client"
 	"github.com/automated-test-orchestrator/cli-go/internal/display"
 	"github.com/automated-test-orchestrator/cli-go/internal/errors"
+	"github.com/automated-test-orchestrator/cli-go/internal/export"
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+	"github.com/automated-test-orchestrator/cli-go/internal/planquery"
 	"github.com/automated-test-orchestrator/cli-go/internal/style"
 	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // testPlansCmd represents the test-plans command group.
@@ -25,23 +30,83 @@ var testPlansCmd = &cobra.Command{
 // testPlansListCmd represents the 'test-plans list' command.
 var testPlansListCmd = &cobra.Command{
 	Use:   "list",
-	Short: "List all test plans",
+	Short: "List test plans, with filtering and sorting",
+	Long: `Lists test plans, newest first. Plans can be filtered by status, name and creation
+time, sorted by creation time, update time or name, and limited to the first N.
+
+--name matches a substring, a glob when it contains * or ?, or a regular expression
+when wrapped in slashes. Matching is case-insensitive.`,
+	Example: `  ato test-plans list --status EXECUTION_FAILED --since 7d
+  ato test-plans list --name 'nightly-*' --sort updated --limit 10
+  ato test-plans list --name '/^release-\d+$/' --sort name --reverse`,
 	Run: func(cmd *cobra.Command, args []string) {
-		style.Info("Fetching all test plans...")
-		apiClient := client.NewAPIClient(viper.GetString("api_url"))
-		plans, err := apiClient.GetAllPlans()
+		statuses, _ := cmd.Flags().GetStringSlice("status")
+		name, _ := cmd.Flags().GetString("name")
+		since, _ := cmd.Flags().GetString("since")
+		until, _ := cmd.Flags().GetString("until")
+		sortKey, _ := cmd.Flags().GetString("sort")
+		limit, _ := cmd.Flags().GetInt("limit")
+		reverse, _ := cmd.Flags().GetBool("reverse")
+
+		query := planquery.Query{Statuses: statuses, Limit: limit, Reverse: reverse}
+		if err := validatePlanStatuses(statuses); err != nil {
+			style.Error("%v", err)
+			exit(1)
+		}
+		if err := query.SetName(name); err != nil {
+			style.Error("%v", err)
+			exit(1)
+		}
+		if err := query.SetSort(sortKey); err != nil {
+			style.Error("%v", err)
+			exit(1)
+		}
+		now := time.Now()
+		if since != "" {
+			t, err := parseTimeFlag(since, now)
+			if err != nil {
+				style.Error("Invalid --since: %v", err)
+				exit(1)
+			}
+			query.Since = t
+		}
+		if until != "" {
+			t, err := parseTimeFlag(until, now)
+			if err != nil {
+				style.Error("Invalid --until: %v", err)
+				exit(1)
+			}
+			query.Until = t
+		}
+		if limit < 0 {
+			style.Error("--limit cannot be negative.")
+			exit(1)
+		}
+
+		style.Info("Fetching test plans...")
+		apiClient := newAPIClient()
+		all, err := apiClient.ListPlans(query.Values())
 		if err != nil {
 			style.Error("Failed to list test plans. %v", err)
-			os.Exit(1)
+			exit(1)
 		}
 
+		// The API does not filter yet, so the query is always applied here as well.
+		plans := query.Apply(all)
 		if len(plans) == 0 {
-			style.Warning("No test plans found.")
+			if len(all) > 0 {
+				style.Warning("No test plans match the given filters (%d plan(s) in total).", len(all))
+			} else {
+				style.Warning("No test plans found.")
+			}
 			return
 		}
 
 		display.PrintTestPlanSummaries(plans)
 		fmt.Println()
+		if len(plans) < len(all) {
+			style.Info("Showing %d of %d test plan(s).", len(plans), len(all))
+		}
 		style.Info("To see the full details of a plan, use 'ato test-plans get <Plan ID>'.")
 	},
 }
@@ -50,49 +115,362 @@ var testPlansListCmd = &cobra.Command{
 var testPlansGetCmd = &cobra.Command{
 	Use:   "get <planId>",
 	Short: "Get the full details of a specific test plan",
-	Args:  cobra.ExactArgs(1),
+	Long: `Get the full details of a specific test plan. Use --export to write the plan's
+components, available tests, entry points and coverage gaps to a file. Use --watch
+to follow the plan until it finishes, as 'test-plans watch' does; an export is then
+written from the final state.`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: firstArgOnly(completePlanIDs),
 	Run: func(cmd *cobra.Command, args []string) {
 		planID := args[0]
-		style.Info("Fetching details for Test Plan ID: %s...", style.ID(planID))
+		exportPath, _ := cmd.Flags().GetString("export")
+		exportFormat, _ := cmd.Flags().GetString("format")
+		watch, _ := cmd.Flags().GetBool("watch")
 
-		apiClient := client.NewAPIClient(viper.GetString("api_url"))
-		plan, err := apiClient.GetPlanStatus(planID)
-		if err != nil {
-			style.Error("Failed to get test plan. %v", err)
-			os.Exit(1)
+		if watch && export.IsStdout(exportPath) {
+			style.Error("--watch cannot be combined with exporting to stdout; export to a file instead.")
+			exit(1)
+		}
+
+		// When streaming the export to stdout, keep all human-readable output on stderr.
+		if export.IsStdout(exportPath) {
+			color.Output = color.Error
+		}
+
+		apiClient := newAPIClient()
+
+		var plan *model.CliTestPlan
+		var watchErr error
+		if watch {
+			interval, _ := cmd.Flags().GetDuration("interval")
+			plan, watchErr = watchPlan(apiClient, planID, watchOptions{Interval: interval})
+			if plan == nil {
+				reportWatchOutcome(plan, watchErr)
+			}
+		} else {
+			style.Info("Fetching details for Test Plan ID: %s...", style.ID(planID))
+
+			var err error
+			plan, err = apiClient.GetPlanStatus(planID)
+			if err != nil {
+				style.Error("Failed to get test plan. %v", err)
+				exit(1)
+			}
+		}
+		rememberPlan(plan.ID)
+
+		if exportPath != "" {
+			if exportFormat == "" {
+				var err error
+				exportFormat, err = export.PlanFormatFromPath(exportPath)
+				if err != nil {
+					style.Error("%v", err)
+					exit(1)
+				}
+			}
+			exporter, err := export.NewPlanExporter(exportFormat)
+			if err != nil {
+				style.Error("%v", err)
+				exit(1)
+			}
+
+			err = export.WriteOutput(exportPath, func(w io.Writer) error {
+				return exporter.ExportPlan(w, plan)
+			})
+			if err != nil {
+				style.Error("Failed to export test plan. %v", err)
+				exit(1)
+			}
+
+			if !export.IsStdout(exportPath) {
+				absPath, _ := filepath.Abs(exportPath)
+				style.Success("Successfully exported test plan to %s", absPath)
+			}
+		} else if !watch {
+			display.PrintTestPlanDetails(plan)
 		}
 
-		display.PrintTestPlanDetails(plan)
+		if watch {
+			reportWatchOutcome(plan, watchErr)
+		}
+	},
+}
+
+// testPlansWatchCmd represents the 'test-plans watch' command.
+var testPlansWatchCmd = &cobra.Command{
+	Use:   "watch <planId>",
+	Short: "Follow a test plan live until it finishes",
+	Long: `Polls a test plan and keeps its status, components and results up to date,
+highlighting status transitions and newly arrived results. On a terminal the view is
+redrawn in place; otherwise each change is printed as a timestamped line.
+
+Stops when the plan is COMPLETED, DISCOVERY_FAILED or EXECUTION_FAILED, and exits
+non-zero for the failed states. A plan awaiting test selection is still watched,
+since an execution may be started for it, unless --stop-at-selection is given.`,
+	Example: `  ato test-plans watch 1a2b3c4d-...
+  ato test-plans watch 1a2b3c4d-... --interval 5s --timeout 30m`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: firstArgOnly(completePlanIDs),
+	Run: func(cmd *cobra.Command, args []string) {
+		interval, _ := cmd.Flags().GetDuration("interval")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		stopAtSelection, _ := cmd.Flags().GetBool("stop-at-selection")
+
+		apiClient := newAPIClient()
+		plan, err := watchPlan(apiClient, args[0], watchOptions{Interval: interval, Timeout: timeout, StopAtSelection: stopAtSelection})
+		if plan != nil {
+			rememberPlan(plan.ID)
+		}
+		reportWatchOutcome(plan, err)
 	},
 }
 
 // testPlansRemoveCmd represents the 'test-plans remove' command.
 var testPlansRemoveCmd = &cobra.Command{
-	Use:   "rm <planId>",
-	Short: "Remove a test plan by its ID",
-	Long:  `Removes a test plan and all of its associated data, including components and execution results.`,
-	Args:  cobra.ExactArgs(1),
+	Use:   "rm [planId...]",
+	Short: "Remove test plans by ID or by status and age",
+	Long: `Removes test plans and all of their associated data, including components and
+execution results. The plans and what will be lost are shown before asking for
+confirmation; use --yes to skip the prompt in scripts.
+
+Instead of IDs, plans can be selected with --status and --older-than. Plans that are
+still DISCOVERING or EXECUTING are skipped unless --status names those statuses.
+Use --dry-run to see which plans would be removed without removing them.`,
+	Example: `  ato test-plans rm 1a2b3c4d-...
+  ato test-plans rm --status DISCOVERY_FAILED --older-than 30d --dry-run
+  ato test-plans rm --status DISCOVERY_FAILED,EXECUTION_FAILED --older-than 2w --yes`,
+	ValidArgsFunction: completePlanIDs,
+	Run: func(cmd *cobra.Command, args []string) {
+		statuses, _ := cmd.Flags().GetStringSlice("status")
+		olderThan, _ := cmd.Flags().GetString("older-than")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		bulk := len(statuses) > 0 || olderThan != ""
+		if len(args) == 0 && !bulk {
+			style.Error("Specify plan IDs, or select plans with --status and/or --older-than.")
+			exit(1)
+		}
+		if len(args) > 0 && bulk {
+			style.Error("Plan IDs cannot be combined with --status or --older-than.")
+			exit(1)
+		}
+
+		if err := validatePlanStatuses(statuses); err != nil {
+			style.Error("%v", err)
+			exit(1)
+		}
+
+		var cutoff time.Time
+		if olderThan != "" {
+			age, err := parseAge(olderThan)
+			if err != nil {
+				style.Error("Invalid --older-than: %v", err)
+				exit(1)
+			}
+			cutoff = time.Now().Add(-age)
+		}
+
+		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+		s.Suffix = " Finding test plans..."
+		s.Start()
+
+		apiClient := newAPIClient()
+		var planIDs []string
+		seen := make(map[string]bool)
+		for _, id := range args {
+			if !seen[id] {
+				seen[id] = true
+				planIDs = append(planIDs, id)
+			}
+		}
+
+		var skippedRunning int
+		if bulk {
+			summaries, err := apiClient.GetAllPlans()
+			if err != nil {
+				errors.HandleCLIError(s, err)
+			}
+			query := planquery.Query{Statuses: statuses, Until: cutoff, Reverse: true}
+			for _, p := range query.Apply(summaries) {
+				// Deleting a running plan races its background discovery or execution.
+				if isRunningStatus(p.Status) && !containsFold(statuses, p.Status) {
+					skippedRunning++
+					continue
+				}
+				planIDs = append(planIDs, p.ID)
+			}
+		}
+
+		// Load each plan to show how many components and results will go with it.
+		var plans []*model.CliTestPlan
+		for i, id := range planIDs {
+			s.Suffix = fmt.Sprintf(" Loading plan %d of %d...", i+1, len(planIDs))
+			plan, err := apiClient.GetPlanStatus(id)
+			if err != nil {
+				errors.HandleCLIError(s, err)
+			}
+			plans = append(plans, plan)
+		}
+		s.Stop()
+
+		if skippedRunning > 0 {
+			style.Warning("Skipped %d plan(s) that are still DISCOVERING or EXECUTING; name those statuses with --status to remove them.", skippedRunning)
+		}
+		if len(plans) == 0 {
+			style.Warning("No test plans match the given filters.")
+			return
+		}
+
+		display.PrintPlanDeletionImpact(plans)
+		components, results := 0, 0
+		for _, p := range plans {
+			components += len(p.PlanComponents)
+			results += len(p.EnrichedResults())
+		}
+		summary := fmt.Sprintf("%d test plan(s), %d component(s) and %d execution result(s)", len(plans), components, results)
+
+		if dryRun {
+			style.Info("Dry run: %s would be removed.", summary)
+			return
+		}
+		if !confirmDestructive(cmd, fmt.Sprintf("Permanently remove %s?", summary)) {
+			style.Warning("Nothing was removed.")
+			return
+		}
+
+		var failed int
+		for _, p := range plans {
+			if err := apiClient.DeleteTestPlan(p.ID); err != nil {
+				failed++
+				style.Error("Failed to remove test plan %s: %s", p.ID, errors.FormatError(err))
+				continue
+			}
+			style.Success("Test plan \"%s\" was successfully removed.", p.ID)
+		}
+		if failed > 0 {
+			exit(1)
+		}
+	},
+}
+
+// planStatuses lists every status a test plan can have.
+var planStatuses = []string{"DISCOVERING", "AWAITING_SELECTION", "EXECUTING", "COMPLETED", "DISCOVERY_FAILED", "EXECUTION_FAILED"}
+
+// isRunningStatus reports whether a plan with this status is still being worked on
+// in the background.
+func isRunningStatus(status string) bool {
+	return status == "DISCOVERING" || status == "EXECUTING"
+}
+
+// validatePlanStatuses checks --status values against the known plan statuses.
+func validatePlanStatuses(statuses []string) error {
+	for _, st := range statuses {
+		if !containsFold(planStatuses, st) {
+			return fmt.Errorf("unknown status %q; valid statuses are %s", st, strings.Join(planStatuses, ", "))
+		}
+	}
+	return nil
+}
+
+// testPlansWaitCmd represents the 'test-plans wait' command.
+var testPlansWaitCmd = &cobra.Command{
+	Use:   "wait <planId>",
+	Short: "Wait for a detached discovery or execution and report on it",
+	Long: `Attaches to a plan started with 'discover --detach' or 'execute --detach' and
+waits for it, then reports exactly as the blocking command would have: the discovery
+result for a discovering plan, or the execution report and any --export files for an
+executing plan. A plan that has already finished is reported straight away.
+
+Exits non-zero if the plan fails or --timeout is reached.`,
+	Example: `  PLAN=$(ato execute -p "$PLAN" -c ci --detach)
+  ato test-plans wait "$PLAN" --timeout 45m --export results.xml`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: firstArgOnly(completePlanIDs),
 	Run: func(cmd *cobra.Command, args []string) {
 		planID := args[0]
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Suffix = fmt.Sprintf(" Deleting test plan %s...", planID)
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		creds, _ := cmd.Flags().GetString("creds")
+
+		exportTargets, err := exportTargetsFromFlags(cmd)
+		if err != nil {
+			style.Error("%v", err)
+			exit(1)
+		}
+
+		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+		s.Suffix = fmt.Sprintf(" Looking up plan %s...", style.ID(planID))
 		s.Start()
 
-		apiClient := client.NewAPIClient(viper.GetString("api_url"))
-		if err := apiClient.DeleteTestPlan(planID); err != nil {
+		apiClient := newAPIClient()
+		plan, err := apiClient.GetPlanStatus(planID)
+		if err != nil {
 			errors.HandleCLIError(s, err)
 		}
+		rememberPlan(plan.ID)
 
-		s.Stop()
-		style.Success("Test plan \"%s\" was successfully removed.", planID)
+		switch plan.Status {
+		case "DISCOVERING", "DISCOVERY_FAILED", "AWAITING_SELECTION":
+			if len(exportTargets) > 0 {
+				s.Stop()
+				style.Warning("Plan %s has not been executed, so there are no results to export.", planID)
+				s.Start()
+			}
+			awaitDiscovery(apiClient, s, planID, timeout)
+		default: // EXECUTING, COMPLETED and EXECUTION_FAILED
+			awaitExecution(apiClient, s, planID, nil, timeout, exportTargets, creds)
+		}
 	},
 }
 
+// containsFold reports whether values contains s, ignoring case.
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), s) {
+			return true
+		}
+	}
+	return false
+}
+
 func init() {
 	rootCmd.AddCommand(testPlansCmd)
 	testPlansCmd.AddCommand(testPlansListCmd)
+	testPlansListCmd.Flags().StringSlice("status", nil, "Only plans with these statuses (e.g. COMPLETED,EXECUTION_FAILED)")
+	testPlansListCmd.Flags().String("name", "", "Only plans whose name matches: substring, glob (nightly-*) or /regex/")
+	testPlansListCmd.Flags().String("since", "", "Only plans created after this time (e.g. 7d, 2024-01-31 or RFC 3339)")
+	testPlansListCmd.Flags().String("until", "", "Only plans created before this time (e.g. 1d, 2024-02-01 or RFC 3339)")
+	testPlansListCmd.Flags().String("sort", planquery.SortCreated, fmt.Sprintf("Sort by %s", strings.Join(planquery.SortKeys, ", ")))
+	testPlansListCmd.Flags().Bool("reverse", false, "Reverse the sort order")
+	testPlansListCmd.Flags().Int("limit", 0, "Show at most this many plans (0 for all)")
+	testPlansListCmd.RegisterFlagCompletionFunc("status", completeList(planStatuses...))
+	testPlansListCmd.RegisterFlagCompletionFunc("sort", completeValues(planquery.SortKeys...))
+	testPlansListCmd.Flags().SortFlags = false
 	testPlansCmd.AddCommand(testPlansGetCmd)
+	testPlansGetCmd.Flags().String("export", "", "Path to export the plan's coverage inventory to, or '-' for stdout")
+	testPlansGetCmd.Flags().String("format", "", fmt.Sprintf("Format of the export file (%s); inferred from the file extension if omitted", strings.Join(export.SupportedPlanFormats, ", ")))
+	testPlansGetCmd.Flags().BoolP("watch", "w", false, "Keep the plan on screen and update it until it finishes")
+	testPlansGetCmd.Flags().Duration("interval", 2*time.Second, "How often to refresh with --watch")
+	testPlansGetCmd.RegisterFlagCompletionFunc("format", completeValues(export.SupportedPlanFormats...))
+	testPlansGetCmd.Flags().SortFlags = false
+	testPlansCmd.AddCommand(testPlansWatchCmd)
+	testPlansWatchCmd.Flags().Duration("interval", 2*time.Second, "How often to refresh the plan")
+	testPlansWatchCmd.Flags().Duration("timeout", 0, "Give up after this long (e.g. 30m); 0 waits indefinitely")
+	testPlansWatchCmd.Flags().Bool("stop-at-selection", false, "Also stop when discovery finishes and the plan awaits test selection")
+	testPlansWatchCmd.Flags().SortFlags = false
+	testPlansCmd.AddCommand(testPlansWaitCmd)
+	testPlansWaitCmd.Flags().Duration("timeout", 0, "Give up after this long (e.g. 45m); 0 waits indefinitely")
+	testPlansWaitCmd.Flags().StringP("creds", "c", "", "Credential profile the plan ran with, recorded in JUnit exports")
+	testPlansWaitCmd.RegisterFlagCompletionFunc("creds", completeProfiles)
+	addExportFlags(testPlansWaitCmd)
+	testPlansWaitCmd.Flags().SortFlags = false
+
 	testPlansCmd.AddCommand(testPlansRemoveCmd)
+	testPlansRemoveCmd.Flags().StringSlice("status", nil, "Remove plans with these statuses (e.g. DISCOVERY_FAILED,EXECUTION_FAILED)")
+	testPlansRemoveCmd.Flags().String("older-than", "", "Remove plans created longer ago than this (e.g. 30d, 2w, 12h)")
+	testPlansRemoveCmd.Flags().Bool("dry-run", false, "Show which plans would be removed without removing them")
+	testPlansRemoveCmd.RegisterFlagCompletionFunc("status", completeList(planStatuses...))
+	addYesFlag(testPlansRemoveCmd)
+	testPlansRemoveCmd.Flags().SortFlags = false
 
 	testPlansCmd.Flags().SortFlags = false
 }
@@ -0,0 +1,46 @@
+// automated-test-orchestrator-cli/cmd/time_flags.go
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseAge parses a relative age such as "90d", "2w", "36h" or "30m". Days and
+// weeks are accepted in addition to the units understood by time.ParseDuration.
+func parseAge(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if n, ok := strings.CutSuffix(value, suffix); ok {
+			count, err := strconv.Atoi(n)
+			if err != nil || count < 0 {
+				return 0, fmt.Errorf("invalid duration %q", value)
+			}
+			return time.Duration(count) * unit, nil
+		}
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration %q: use a value such as 30m, 12h, 7d or 2w", value)
+	}
+	return d, nil
+}
+
+// parseTimeFlag resolves a point in time given either as a relative age
+// (e.g. "7d" meaning seven days ago), an RFC 3339 timestamp or a YYYY-MM-DD date
+// in local time.
+func parseTimeFlag(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+	age, err := parseAge(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: use a duration such as 7d, an RFC 3339 timestamp or a YYYY-MM-DD date", value)
+	}
+	return now.Add(-age), nil
+}
@@ -0,0 +1,73 @@
+// automated-test-orchestrator-cli/cmd/ui.go
+package cmd
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/export"
+	"github.com/automated-test-orchestrator/cli-go/internal/style"
+	"github.com/automated-test-orchestrator/cli-go/internal/tui"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// uiCmd represents the ui command.
+var uiCmd = &cobra.Command{
+	Use:   "ui",
+	Short: "Open a terminal dashboard of plans and results",
+	Long: `Opens a full-screen dashboard with three panes: test plans, the selected plan's
+components and their test coverage, and its execution results, whose test cases
+can be expanded.
+
+From the dashboard you can execute the tests of marked components (or all of them),
+rerun only the failures, export the results and delete plans. Executing needs a
+credential profile, given with --creds. Plans that are discovering or executing
+are refreshed in the background. Press '?' in the dashboard for the keys.`,
+	Example: `  ato ui
+  ato ui --creds dev --export-dir reports --export-format junit`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		creds, _ := cmd.Flags().GetString("creds")
+		interval, _ := cmd.Flags().GetDuration("interval")
+		exportDir, _ := cmd.Flags().GetString("export-dir")
+		exportFormat, _ := cmd.Flags().GetString("export-format")
+
+		if !term.IsTerminal(int(os.Stdin.Fd())) || !term.IsTerminal(int(os.Stdout.Fd())) {
+			style.Error("'ato ui' needs an interactive terminal. Use 'ato test-plans watch' or 'ato results' in scripts.")
+			exit(1)
+		}
+		if !export.IsSupportedFormat(exportFormat) {
+			style.Error("Unsupported export format '%s'. Supported formats: %s", exportFormat, strings.Join(export.SupportedFormats, ", "))
+			exit(1)
+		}
+		if interval < time.Second {
+			style.Error("--interval must be at least 1s.")
+			exit(1)
+		}
+
+		err := tui.Run(tui.Options{
+			Client:            newAPIClient(),
+			CredentialProfile: creds,
+			Interval:          interval,
+			ExportDir:         exportDir,
+			ExportFormat:      exportFormat,
+		})
+		if err != nil {
+			style.Error("%v", err)
+			exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(uiCmd)
+	uiCmd.Flags().StringP("creds", "c", "", "Credential profile used to execute tests from the dashboard")
+	uiCmd.Flags().Duration("interval", 3*time.Second, "How often to refresh plans in the background")
+	uiCmd.Flags().String("export-dir", ".", "Directory the 'e' key exports results to, as <planId>.<ext>")
+	uiCmd.Flags().String("export-format", "json", "Format the 'e' key exports results in ("+strings.Join(export.SupportedFormats, ", ")+")")
+	uiCmd.RegisterFlagCompletionFunc("creds", completeProfiles)
+	uiCmd.RegisterFlagCompletionFunc("export-format", completeValues(export.SupportedFormats...))
+	uiCmd.Flags().SortFlags = false
+}
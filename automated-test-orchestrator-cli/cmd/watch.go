@@ -0,0 +1,179 @@
+// automated-test-orchestrator-cli/cmd/watch.go
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/client"
+	"github.com/automated-test-orchestrator/cli-go/internal/display"
+	"github.com/automated-test-orchestrator/cli-go/internal/errors"
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+	"github.com/automated-test-orchestrator/cli-go/internal/planwatch"
+	"github.com/automated-test-orchestrator/cli-go/internal/style"
+	"github.com/fatih/color"
+	"golang.org/x/term"
+)
+
+// watchOptions controls watchPlan.
+type watchOptions struct {
+	Interval time.Duration
+	Timeout  time.Duration // Zero waits indefinitely
+	// StopAtSelection also stops when discovery has finished and the plan is
+	// waiting for tests to be selected, rather than waiting for an execution.
+	StopAtSelection bool
+}
+
+// transition is a status change and when it was observed.
+type transition struct {
+	at       time.Time
+	from, to string
+}
+
+// watchPlan polls a plan until it reaches a terminal state and returns the last
+// state seen. On a terminal the plan details are redrawn in place after every
+// poll; otherwise each change is printed as a timestamped line, which suits CI logs.
+func watchPlan(apiClient *client.APIClient, planID string, opts watchOptions) (*model.CliTestPlan, error) {
+	interactive := term.IsTerminal(int(os.Stdout.Fd()))
+	started := time.Now()
+
+	var (
+		prev        *model.CliTestPlan
+		transitions []transition
+	)
+	for {
+		plan, err := apiClient.GetPlanStatus(planID)
+		if err != nil {
+			return prev, err
+		}
+
+		events := planwatch.Diff(prev, plan)
+		now := time.Now()
+		for _, e := range events {
+			if e.Kind == planwatch.StatusChanged {
+				transitions = append(transitions, transition{at: now, from: e.From, to: e.To})
+			}
+		}
+
+		if interactive {
+			redrawWatch(plan, transitions, events, prev == nil)
+		} else {
+			logWatchEvents(plan, events, prev == nil)
+		}
+		prev = plan
+
+		if planwatch.IsTerminal(plan.Status) || (opts.StopAtSelection && plan.Status == "AWAITING_SELECTION") {
+			return plan, nil
+		}
+		if opts.Timeout > 0 && time.Since(started) >= opts.Timeout {
+			return plan, fmt.Errorf("timed out after %s waiting for plan %s (status %s)", opts.Timeout, planID, plan.Status)
+		}
+		time.Sleep(opts.Interval)
+	}
+}
+
+// redrawWatch clears the terminal and prints the plan with its status history,
+// highlighting results that arrived in the latest poll.
+func redrawWatch(plan *model.CliTestPlan, transitions []transition, events []planwatch.Event, first bool) {
+	fmt.Fprint(color.Output, "\033[H\033[2J")
+	fmt.Fprintln(color.Output, style.Header(fmt.Sprintf("Watching plan %s", plan.Name)))
+	fmt.Fprintln(color.Output, style.Faint(fmt.Sprintf("Last updated %s. Press Ctrl+C to stop watching.", time.Now().Format("15:04:05"))))
+
+	if len(transitions) > 0 {
+		fmt.Fprintln(color.Output)
+		for _, t := range transitions {
+			fmt.Fprintf(color.Output, "  %s %s %s %s\n", style.Faint(t.at.Format("15:04:05")), t.from, style.IconArrow, colorPlanStatus(t.to))
+		}
+	}
+
+	display.PrintTestPlanDetails(plan)
+
+	if first {
+		return
+	}
+	var fresh []planwatch.Event
+	for _, e := range events {
+		if e.Kind == planwatch.ResultAdded {
+			fresh = append(fresh, e)
+		}
+	}
+	if len(fresh) > 0 {
+		fmt.Fprintln(color.Output)
+		fmt.Fprintln(color.Output, style.Header("New Results"))
+		for _, e := range fresh {
+			fmt.Fprintln(color.Output, "  "+formatResultLine(e.Result))
+		}
+	}
+}
+
+// logWatchEvents prints one timestamped line per change.
+func logWatchEvents(plan *model.CliTestPlan, events []planwatch.Event, first bool) {
+	stamp := time.Now().Format(time.RFC3339)
+	if first {
+		fmt.Fprintf(color.Output, "%s Plan %s (%s) is %s\n", stamp, plan.Name, plan.ID, plan.Status)
+	}
+	for _, e := range events {
+		switch e.Kind {
+		case planwatch.StatusChanged:
+			fmt.Fprintf(color.Output, "%s Status %s -> %s\n", stamp, e.From, e.To)
+		case planwatch.ResultAdded:
+			fmt.Fprintf(color.Output, "%s Result %s\n", stamp, formatResultLine(e.Result))
+		}
+	}
+}
+
+// formatResultLine summarises a result as "<STATUS> <component> / <test>".
+func formatResultLine(r model.CliEnrichedTestExecutionResult) string {
+	component := r.PlanComponentID
+	if r.ComponentName != nil && *r.ComponentName != "" {
+		component = *r.ComponentName
+	}
+	test := r.TestComponentID
+	if r.TestComponentName != nil && *r.TestComponentName != "" {
+		test = *r.TestComponentName
+	}
+
+	status := style.Green(r.Status)
+	if r.Status != "SUCCESS" {
+		status = style.Red(r.Status)
+	}
+	return fmt.Sprintf("%s %s / %s", status, component, test)
+}
+
+// colorPlanStatus colours a plan status the same way the plan tables do.
+func colorPlanStatus(status string) string {
+	switch {
+	case planwatch.IsFailed(status):
+		return style.Red(status)
+	case status == "COMPLETED":
+		return style.Green(status)
+	default:
+		return style.Yellow(status)
+	}
+}
+
+// reportWatchOutcome prints how a watched plan ended and exits non-zero unless
+// it completed successfully (or stopped at selection when asked to).
+func reportWatchOutcome(plan *model.CliTestPlan, err error) {
+	if err != nil {
+		if plan == nil {
+			errors.HandleCLIError(nil, err)
+		}
+		style.Error("%v", err)
+		exit(1)
+	}
+
+	switch {
+	case planwatch.IsFailed(plan.Status):
+		style.Error("Plan %s ended with status %s.", plan.ID, plan.Status)
+		if plan.FailureReason != nil {
+			style.Error("Reason: %s", *plan.FailureReason)
+		}
+		exit(1)
+	case plan.Status == "AWAITING_SELECTION":
+		style.Success("Discovery finished; plan %s is awaiting test selection.", plan.ID)
+	default:
+		style.Success("Plan %s finished with status %s.", plan.ID, plan.Status)
+	}
+}
@@ -3,6 +3,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,6 +16,10 @@ import (
 	"github.com/automated-test-orchestrator/cli-go/internal/model"
 )
 
+// APIVersion is the API contract version this client speaks; the server serves
+// it under /api/<version>.
+const APIVersion = "v1"
+
 // APIClient is responsible for making HTTP requests to the backend service.
 type APIClient struct {
 	BaseURL    string
@@ -127,6 +132,41 @@ func (c *APIClient) AddCredentialProfile(data model.AddCredentialRequest) error
 	return nil
 }
 
+// UpdateCredentialProfile changes individual fields of an existing credential
+// profile. The server applies the change in a single write, so the profile stays
+// usable throughout, which makes this suitable for rotating secrets.
+func (c *APIClient) UpdateCredentialProfile(profileName string, data model.UpdateCredentialRequest) (*model.CliCredentialProfile, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("internal error marshaling request: %w", err)
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/credentials/%s", c.BaseURL, url.PathEscape(profileName)), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("internal error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, handleRequestError(resp)
+	}
+
+	var apiResponse struct {
+		Data model.CliCredentialProfile `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode successful API response: %w", err)
+	}
+
+	return &apiResponse.Data, nil
+}
+
 // DeleteCredentialProfile removes a credential profile by its name.
 func (c *APIClient) DeleteCredentialProfile(profileName string) error {
 	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/credentials/%s", c.BaseURL, profileName), nil)
@@ -178,6 +218,60 @@ func (c *APIClient) GetAllMappings() ([]model.CliMapping, error) {
 	return apiResponse.Data, nil
 }
 
+// GetMapping retrieves a single test mapping by its unique ID.
+func (c *APIClient) GetMapping(mappingID string) (*model.CliMapping, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/mappings/%s", c.BaseURL, url.PathEscape(mappingID)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("internal error creating request: %w", err)
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, handleRequestError(resp)
+	}
+
+	var apiResponse struct {
+		Data model.CliMapping `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode successful API response: %w", err)
+	}
+
+	return &apiResponse.Data, nil
+}
+
+// GetMappingsByComponent retrieves all test mappings for a main component ID.
+func (c *APIClient) GetMappingsByComponent(mainComponentID string) ([]model.CliMapping, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/mappings/component/%s", c.BaseURL, url.PathEscape(mainComponentID)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("internal error creating request: %w", err)
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, handleRequestError(resp)
+	}
+
+	var apiResponse struct {
+		Data []model.CliMapping `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode successful API response: %w", err)
+	}
+
+	return apiResponse.Data, nil
+}
+
 // CreateMapping creates a single new test mapping.
 func (c *APIClient) CreateMapping(data model.CreateMappingRequest) (*model.CliMapping, error) {
 	body, err := json.Marshal(data)
@@ -211,6 +305,39 @@ func (c *APIClient) CreateMapping(data model.CreateMappingRequest) (*model.CliMa
 	return &apiResponse.Data, nil
 }
 
+// UpdateMapping updates one or more fields of an existing test mapping.
+func (c *APIClient) UpdateMapping(mappingID string, data model.UpdateMappingRequest) (*model.CliMapping, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("internal error marshaling request: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/mappings/%s", c.BaseURL, url.PathEscape(mappingID)), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("internal error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, handleRequestError(resp)
+	}
+
+	var apiResponse struct {
+		Data model.CliMapping `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode successful API response: %w", err)
+	}
+
+	return &apiResponse.Data, nil
+}
+
 // DeleteMapping deletes a test mapping by its unique ID.
 func (c *APIClient) DeleteMapping(mappingID string) error {
 	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/mappings/%s", c.BaseURL, mappingID), nil)
@@ -237,10 +364,18 @@ func (c *APIClient) DeleteMapping(mappingID string) error {
 
 // GetAllPlans retrieves a summary list of all test plans.
 func (c *APIClient) GetAllPlans() ([]model.CliTestPlanSummary, error) {
+	return c.ListPlans(nil)
+}
+
+// ListPlans retrieves test plan summaries, passing query as URL parameters.
+// Parameters the API does not support are ignored by it, so callers must still
+// filter the response themselves.
+func (c *APIClient) ListPlans(query url.Values) ([]model.CliTestPlanSummary, error) {
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s/test-plans", c.BaseURL), nil)
 	if err != nil {
 		return nil, fmt.Errorf("internal error creating request: %w", err)
 	}
+	req.URL.RawQuery = query.Encode()
 
 	resp, err := c.do(req)
 	if err != nil {
@@ -264,7 +399,12 @@ func (c *APIClient) GetAllPlans() ([]model.CliTestPlanSummary, error) {
 
 // GetPlanStatus fetches the full details of a test plan by its ID.
 func (c *APIClient) GetPlanStatus(planID string) (*model.CliTestPlan, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/test-plans/%s", c.BaseURL, planID), nil)
+	return c.getPlanStatus(context.Background(), planID)
+}
+
+// getPlanStatus fetches a plan with a request that is abandoned when ctx is done.
+func (c *APIClient) getPlanStatus(ctx context.Context, planID string) (*model.CliTestPlan, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/test-plans/%s", c.BaseURL, planID), nil)
 	if err != nil {
 		return nil, fmt.Errorf("internal error creating request: %w", err)
 	}
@@ -361,17 +501,24 @@ func (c *APIClient) InitiateDiscovery(name string, planType string, compIDs []st
 	return apiResponse.Data.ID, nil
 }
 
-// PollForPlanCompletion polls the API until the discovery phase is complete.
-func (c *APIClient) PollForPlanCompletion(planID string) (*model.CliTestPlan, error) {
+// PollForPlanCompletion polls the API until the discovery phase is complete, or
+// until ctx is done, in which case ctx.Err() is returned.
+func (c *APIClient) PollForPlanCompletion(ctx context.Context, planID string) (*model.CliTestPlan, error) {
 	for {
-		plan, err := c.GetPlanStatus(planID)
+		plan, err := c.getPlanStatus(ctx, planID)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		if err != nil {
 			return nil, err
 		}
 
 		switch plan.Status {
 		case "DISCOVERING":
-			time.Sleep(2 * time.Second) // Wait before polling again
+			// Wait before polling again
+			if err := sleep(ctx, 2*time.Second); err != nil {
+				return nil, err
+			}
 			continue
 		case "DISCOVERY_FAILED":
 			return plan, ErrPlanDiscoveryFailed
@@ -412,17 +559,34 @@ func (c *APIClient) InitiateExecution(planID string, testsToRun []string, profil
 }
 
 // PollForExecutionCompletion polls the API until the execution phase is complete.
-func (c *APIClient) PollForExecutionCompletion(planID string) (*model.CliTestPlan, error) {
+func (c *APIClient) PollForExecutionCompletion(ctx context.Context, planID string) (*model.CliTestPlan, error) {
+	return c.PollForExecutionProgress(ctx, planID, nil)
+}
+
+// PollForExecutionProgress polls the API until the execution phase is complete,
+// calling onPoll (if set) with the plan after every poll so results can be
+// reported as they arrive. When ctx is done it returns ctx.Err() without calling
+// onPoll again.
+func (c *APIClient) PollForExecutionProgress(ctx context.Context, planID string, onPoll func(*model.CliTestPlan)) (*model.CliTestPlan, error) {
 	for {
-		plan, err := c.GetPlanStatus(planID)
+		plan, err := c.getPlanStatus(ctx, planID)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		if err != nil {
 			return nil, err
 		}
+		if onPoll != nil {
+			onPoll(plan)
+		}
 
 		switch plan.Status {
 		// These are transient states, so we continue polling.
 		case "EXECUTING", "AWAITING_SELECTION":
-			time.Sleep(3 * time.Second) // Wait before polling again
+			// Wait before polling again
+			if err := sleep(ctx, 3*time.Second); err != nil {
+				return nil, err
+			}
 			continue
 		case "EXECUTION_FAILED":
 			return plan, ErrPlanExecutionFailed
@@ -435,6 +599,18 @@ func (c *APIClient) PollForExecutionCompletion(planID string) (*model.CliTestPla
 	}
 }
 
+// sleep waits for d, returning ctx.Err() early if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // ###############################################################
 // Results Retrieval
 // ###############################################################
@@ -483,3 +659,35 @@ func (c *APIClient) GetExecutionResults(filters model.GetResultsFilters) ([]mode
 
 	return apiResponse.Data, nil
 }
+
+// ###############################################################
+// Server Information
+// ###############################################################
+
+// GetServerVersion retrieves the server version and the API contract version it serves.
+// Servers that predate the version endpoint respond with a 404 APIError.
+func (c *APIClient) GetServerVersion() (*model.CliServerVersion, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/version", c.BaseURL), nil)
+	if err != nil {
+		return nil, fmt.Errorf("internal error creating request: %w", err)
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, handleRequestError(resp)
+	}
+
+	var apiResponse struct {
+		Data model.CliServerVersion `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode successful API response: %w", err)
+	}
+
+	return &apiResponse.Data, nil
+}
@@ -2,91 +2,331 @@
 package csv
 
 import (
+	"bytes"
 	"encoding/csv"
 	"fmt"
 	"io"
-	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/automated-test-orchestrator/cli-go/internal/model"
 )
 
-// ParseMappingCsv reads and parses CSV content for bulk-importing mappings.
-// It requires 'mainComponentId' and 'testComponentId' headers and supports
-// optional 'testComponentName', 'isDeployed', and 'isPackage' columns.
-func ParseMappingCsv(reader io.Reader) ([]model.CreateMappingRequest, error) {
-	r := csv.NewReader(reader)
-	records, err := r.ReadAll()
+// Canonical mapping column names.
+const (
+	ColumnMainComponentID   = "mainComponentId"
+	ColumnMainComponentName = "mainComponentName"
+	ColumnTestComponentID   = "testComponentId"
+	ColumnTestComponentName = "testComponentName"
+	ColumnIsDeployed        = "isDeployed"
+	ColumnIsPackaged        = "isPackaged"
+)
+
+// mappingColumnAliases maps normalized header names (see normalizeHeader) to
+// canonical columns, so headers such as "Main Component ID", "test_id" or the
+// legacy "isPackage" are all recognised.
+var mappingColumnAliases = map[string]string{
+	"maincomponentid":   ColumnMainComponentID,
+	"maincomponent":     ColumnMainComponentID,
+	"mainid":            ColumnMainComponentID,
+	"componentid":       ColumnMainComponentID,
+	"maincomponentname": ColumnMainComponentName,
+	"mainname":          ColumnMainComponentName,
+	"componentname":     ColumnMainComponentName,
+	"testcomponentid":   ColumnTestComponentID,
+	"testcomponent":     ColumnTestComponentID,
+	"testid":            ColumnTestComponentID,
+	"testcomponentname": ColumnTestComponentName,
+	"testname":          ColumnTestComponentName,
+	"isdeployed":        ColumnIsDeployed,
+	"deployed":          ColumnIsDeployed,
+	"ispackaged":        ColumnIsPackaged,
+	"ispackage":         ColumnIsPackaged,
+	"packaged":          ColumnIsPackaged,
+}
+
+// Options controls how a mapping CSV is read.
+type Options struct {
+	// Strict rejects rows with unparseable booleans or a different number of
+	// fields than the header. Otherwise such values are ignored and reported as warnings.
+	Strict bool
+	// Delimiter is the field separator. When zero it is detected from the header
+	// line, choosing between comma, semicolon and tab.
+	Delimiter rune
+}
+
+// RowError describes a problem with a single row of a mapping CSV.
+type RowError struct {
+	Line    int    `json:"line"`             // Line number in the file, counting the header as line 1
+	Column  string `json:"column,omitempty"` // Canonical column name, if the problem is with one field
+	Value   string `json:"value,omitempty"`  // The offending value, if any
+	Message string `json:"message"`
+}
+
+func (e *RowError) Error() string {
+	if e.Column != "" {
+		return fmt.Sprintf("line %d, column %s: %s", e.Line, e.Column, e.Message)
+	}
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// MappingRow is a single data row from a mapping CSV, kept alongside its source
+// record so failures can be reported against the original input.
+type MappingRow struct {
+	Line    int                         // Line number in the file, counting the header as line 1
+	Record  []string                    // The raw fields as read from the file
+	Mapping *model.CreateMappingRequest // Nil when the row could not be parsed
+	Err     *RowError                   // Why the row could not be parsed
+}
+
+// MappingCsv is a parsed mapping CSV file.
+type MappingCsv struct {
+	Header    []string
+	Delimiter rune
+	Rows      []MappingRow
+	// Warnings lists values that were ignored in non-strict mode. The rows they
+	// belong to were still parsed.
+	Warnings []*RowError
+}
+
+// Errors returns the errors of all rows that could not be parsed.
+func (f *MappingCsv) Errors() []*RowError {
+	var errs []*RowError
+	for _, row := range f.Rows {
+		if row.Err != nil {
+			errs = append(errs, row.Err)
+		}
+	}
+	return errs
+}
+
+// Mappings returns the requests of all rows that were parsed successfully.
+func (f *MappingCsv) Mappings() []model.CreateMappingRequest {
+	mappings := []model.CreateMappingRequest{}
+	for _, row := range f.Rows {
+		if row.Mapping != nil {
+			mappings = append(mappings, *row.Mapping)
+		}
+	}
+	return mappings
+}
+
+// ParseMappingCsv reads and parses CSV content for bulk-importing mappings. It
+// returns the mappings from every valid row along with the errors for rows that
+// were skipped. See ReadMappingCsv for the accepted columns.
+func ParseMappingCsv(reader io.Reader, opts Options) ([]model.CreateMappingRequest, []*RowError, error) {
+	file, err := ReadMappingCsv(reader, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return file.Mappings(), file.Errors(), nil
+}
+
+// ReadMappingCsv parses mapping CSV content row by row. It requires main and
+// test component ID columns and supports optional component name, isDeployed
+// and isPackaged columns. Headers are matched case-insensitively, ignoring
+// spaces, underscores and hyphens, and common aliases are accepted. Rows that
+// cannot be turned into a mapping are returned with Err set rather than dropped.
+func ReadMappingCsv(reader io.Reader, opts Options) (*MappingCsv, error) {
+	data, err := io.ReadAll(reader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read CSV data: %w", err)
 	}
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
 
-	if len(records) < 2 { // Must have a header and at least one data row
-		return []model.CreateMappingRequest{}, nil
+	delimiter := opts.Delimiter
+	if delimiter == 0 {
+		delimiter = detectDelimiter(data)
 	}
 
-	header := records[0]
-	headerMap := make(map[string]int)
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = delimiter
+	r.FieldsPerRecord = -1 // Ragged rows are handled below, with line numbers.
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return &MappingCsv{Delimiter: delimiter}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int)
 	for i, h := range header {
-		headerMap[sanitize(h)] = i
+		canonical, ok := mappingColumnAliases[normalizeHeader(h)]
+		if !ok {
+			continue // Unknown columns, such as 'error' in a failures file, are ignored.
+		}
+		if prev, dup := columns[canonical]; dup {
+			return nil, fmt.Errorf("CSV columns %q and %q both map to %s", sanitize(header[prev]), sanitize(h), canonical)
+		}
+		columns[canonical] = i
 	}
 
 	// Validate required headers
-	requiredHeaders := []string{"mainComponentId", "testComponentId"}
-	for _, reqHeader := range requiredHeaders {
-		if _, ok := headerMap[reqHeader]; !ok {
-			return nil, fmt.Errorf("CSV file is missing required header: %s", reqHeader)
+	for _, required := range []string{ColumnMainComponentID, ColumnTestComponentID} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV file is missing required header: %s", required)
 		}
 	}
 
-	var mappings []model.CreateMappingRequest
-	for i, row := range records[1:] {
-		mainID := row[headerMap["mainComponentId"]]
-		testID := row[headerMap["testComponentId"]]
+	result := &MappingCsv{Header: header, Delimiter: delimiter}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV data: %w", err)
+		}
+		line, _ := r.FieldPos(0)
 
-		if strings.TrimSpace(mainID) == "" || strings.TrimSpace(testID) == "" {
-			fmt.Printf("Skipping row %d: missing required ID\n", i+2)
-			continue
+		if isBlankRecord(record) {
+			continue // Spreadsheet exports often end with rows of empty cells.
 		}
 
-		mapping := model.CreateMappingRequest{
-			MainComponentID: mainID,
-			TestComponentID: testID,
+		row, warnings := parseMappingRecord(record, line, len(header), columns, opts.Strict)
+		result.Rows = append(result.Rows, row)
+		result.Warnings = append(result.Warnings, warnings...)
+	}
+
+	return result, nil
+}
+
+// parseMappingRecord turns one record into a MappingRow. In non-strict mode,
+// problems that do not prevent a mapping from being created are returned as
+// warnings instead.
+func parseMappingRecord(record []string, line, width int, columns map[string]int, strict bool) (MappingRow, []*RowError) {
+	row := MappingRow{Line: line, Record: record}
+	var warnings []*RowError
+
+	if len(record) != width {
+		problem := &RowError{Line: line, Message: fmt.Sprintf("expected %d fields but found %d", width, len(record))}
+		if strict {
+			row.Err = problem
+			return row, nil
 		}
+		warnings = append(warnings, problem)
+	}
 
-		// Handle optional fields
-		if idx, ok := headerMap["mainComponentName"]; ok && idx < len(row) {
-			name := row[idx]
-			if name != "" {
-				mapping.MainComponentName = &name
-			}
+	field := func(column string) (string, bool) {
+		idx, ok := columns[column]
+		if !ok || idx >= len(record) {
+			return "", false
 		}
+		return strings.TrimSpace(record[idx]), true
+	}
+
+	mainID, _ := field(ColumnMainComponentID)
+	if mainID == "" {
+		row.Err = &RowError{Line: line, Column: ColumnMainComponentID, Message: "missing required ID"}
+		return row, warnings
+	}
+	testID, _ := field(ColumnTestComponentID)
+	if testID == "" {
+		row.Err = &RowError{Line: line, Column: ColumnTestComponentID, Message: "missing required ID"}
+		return row, warnings
+	}
+
+	mapping := model.CreateMappingRequest{
+		MainComponentID: mainID,
+		TestComponentID: testID,
+	}
+
+	// Handle optional fields
+	if name, _ := field(ColumnMainComponentName); name != "" {
+		mapping.MainComponentName = &name
+	}
+	if name, _ := field(ColumnTestComponentName); name != "" {
+		mapping.TestComponentName = &name
+	}
 
-		if idx, ok := headerMap["testComponentName"]; ok && idx < len(row) {
-			name := row[idx]
-			if name != "" {
-				mapping.TestComponentName = &name
+	for _, column := range []string{ColumnIsDeployed, ColumnIsPackaged} {
+		raw, _ := field(column)
+		if raw == "" {
+			continue
+		}
+		val, ok := parseBool(raw)
+		if !ok {
+			problem := &RowError{Line: line, Column: column, Value: raw, Message: fmt.Sprintf("invalid boolean %q (use true/false, yes/no or 1/0)", raw)}
+			if strict {
+				row.Err = problem
+				return row, warnings
 			}
+			warnings = append(warnings, problem)
+			continue
+		}
+		if column == ColumnIsDeployed {
+			mapping.IsDeployed = &val
+		} else {
+			mapping.IsPackaged = &val
 		}
+	}
 
-		if idx, ok := headerMap["isDeployed"]; ok && idx < len(row) {
-			val, err := strconv.ParseBool(strings.ToLower(row[idx]))
-			if err == nil {
-				mapping.IsDeployed = &val
-			}
+	row.Mapping = &mapping
+	return row, warnings
+}
+
+// detectDelimiter picks the separator that occurs most often in the header line,
+// outside quotes. Spreadsheets in many locales export with semicolons or tabs.
+func detectDelimiter(data []byte) rune {
+	counts := map[rune]int{}
+	inQuotes := false
+	for _, c := range string(data) {
+		if c == '"' {
+			inQuotes = !inQuotes
+			continue
 		}
+		if inQuotes {
+			continue
+		}
+		if c == '\n' || c == '\r' {
+			break
+		}
+		if c == ',' || c == ';' || c == '\t' {
+			counts[c]++
+		}
+	}
 
-		if idx, ok := headerMap["isPackage"]; ok && idx < len(row) {
-			val, err := strconv.ParseBool(strings.ToLower(row[idx]))
-			if err == nil {
-				mapping.IsPackaged = &val
-			}
+	best := ','
+	for _, c := range []rune{';', '\t'} {
+		if counts[c] > counts[best] {
+			best = c
 		}
+	}
+	return best
+}
+
+// parseBool accepts the boolean spellings commonly found in spreadsheets.
+func parseBool(s string) (bool, bool) {
+	switch strings.ToLower(s) {
+	case "true", "t", "yes", "y", "1":
+		return true, true
+	case "false", "f", "no", "n", "0":
+		return false, true
+	default:
+		return false, false
+	}
+}
 
-		mappings = append(mappings, mapping)
+func isBlankRecord(record []string) bool {
+	for _, f := range record {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
 	}
+	return true
+}
 
-	return mappings, nil
+// normalizeHeader lowercases a header and drops everything but letters and digits.
+func normalizeHeader(h string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(sanitize(h)) {
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
 }
 
 // ParseComponentIdCsv reads a single-column CSV of component IDs.
@@ -0,0 +1,90 @@
+// automated-test-orchestrator-cli/internal/display/checks.go
+package display
+
+import (
+	"fmt"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/style"
+	"github.com/fatih/color"
+)
+
+// Check outcomes.
+const (
+	CheckPass = "PASS"
+	CheckFail = "FAIL"
+	CheckWarn = "WARN"
+	CheckSkip = "SKIP"
+)
+
+// CheckResult is the outcome of a single diagnostic check.
+type CheckResult struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"` // One of CheckPass, CheckFail, CheckWarn or CheckSkip
+	Details string `json:"details"`
+	Hint    string `json:"hint,omitempty"` // How to fix a failure or warning
+}
+
+// PrintChecks renders diagnostic check results in a table.
+func PrintChecks(checks []CheckResult) {
+	table := style.NewTable([]string{"Check", "Result", "Details"})
+
+	for _, c := range checks {
+		status := c.Status
+		switch c.Status {
+		case CheckPass:
+			status = style.Green(status)
+		case CheckFail:
+			status = style.Red(status)
+		case CheckWarn:
+			status = style.Yellow(status)
+		default:
+			status = style.Faint(status)
+		}
+		table.Append([]string{c.Name, status, c.Details})
+	}
+
+	table.Render()
+}
+
+// PrintCheckList renders diagnostic check results one per line, marked ✓, ✗,
+// ! or -, with the remediation hint of failures and warnings below them.
+func PrintCheckList(checks []CheckResult) {
+	width := 0
+	for _, c := range checks {
+		width = max(width, len(c.Name))
+	}
+
+	for _, c := range checks {
+		var mark string
+		switch c.Status {
+		case CheckPass:
+			mark = style.Green("✓")
+		case CheckFail:
+			mark = style.Red("✗")
+		case CheckWarn:
+			mark = style.Yellow("!")
+		default:
+			mark = style.Faint("-")
+		}
+		fmt.Fprintf(color.Output, "%s %-*s  %s\n", mark, width, c.Name, c.Details)
+		if c.Hint != "" && (c.Status == CheckFail || c.Status == CheckWarn) {
+			fmt.Fprintf(color.Output, "  %-*s  %s\n", width, "", style.Faint(style.IconArrow+" "+c.Hint))
+		}
+	}
+}
+
+// CountChecks returns the number of checks with the given status.
+func CountChecks(checks []CheckResult, status string) int {
+	count := 0
+	for _, c := range checks {
+		if c.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// CountFailedChecks returns the number of checks with a CheckFail status.
+func CountFailedChecks(checks []CheckResult) int {
+	return CountChecks(checks, CheckFail)
+}
@@ -12,26 +12,7 @@ import (
 
 // PrintExecutionReport renders a Jest-like summary of test execution results.
 func PrintExecutionReport(plan *model.CliTestPlan) {
-	// Convert CliTestPlan to []CliEnrichedTestExecutionResult to reuse the rendering logic
-	var results []model.CliEnrichedTestExecutionResult
-
-	for _, comp := range plan.PlanComponents {
-		for _, res := range comp.ExecutionResults {
-			enriched := model.CliEnrichedTestExecutionResult{
-				ID:                res.ID,
-				TestPlanID:        plan.ID,
-				TestPlanName:      &plan.Name,
-				PlanComponentID:   comp.ComponentID,
-				ComponentName:     comp.ComponentName,
-				TestComponentID:   res.TestComponentID,
-				TestComponentName: res.TestComponentName,
-				Status:            res.Status,
-				Message:           res.Message,
-				TestCases:         res.TestCases,
-			}
-			results = append(results, enriched)
-		}
-	}
+	results := plan.EnrichedResults()
 
 	if len(results) == 0 {
 		style.Warning("No tests were executed.")
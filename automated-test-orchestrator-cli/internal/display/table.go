@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/automated-test-orchestrator/cli-go/internal/mappingcheck"
+	"github.com/automated-test-orchestrator/cli-go/internal/mappingmatrix"
+	"github.com/automated-test-orchestrator/cli-go/internal/mappingsync"
 	"github.com/automated-test-orchestrator/cli-go/internal/model"
 	"github.com/automated-test-orchestrator/cli-go/internal/style"
+	"github.com/fatih/color"
 )
 
 // PrintCredentialProfiles renders a list of credential profiles in a table.
@@ -52,6 +56,39 @@ func PrintMappings(mappings []model.CliMapping) {
 	table.Render()
 }
 
+// PrintMappingDetails renders every field of a single mapping.
+func PrintMappingDetails(m *model.CliMapping) {
+	table := style.NewTable([]string{"Field", "Value"})
+
+	optionalString := func(s *string) string {
+		if s == nil || *s == "" {
+			return style.Faint("N/A")
+		}
+		return *s
+	}
+	optionalBool := func(b *bool) string {
+		if b == nil {
+			return style.Faint("N/A")
+		}
+		if *b {
+			return style.Green("Yes")
+		}
+		return style.Yellow("No")
+	}
+
+	table.Append([]string{"Mapping ID", style.ID(m.ID)})
+	table.Append([]string{"Main Component ID", m.MainComponentID})
+	table.Append([]string{"Main Component Name", optionalString(m.MainComponentName)})
+	table.Append([]string{"Test Component ID", m.TestComponentID})
+	table.Append([]string{"Test Component Name", optionalString(m.TestComponentName)})
+	table.Append([]string{"Deployed", optionalBool(m.IsDeployed)})
+	table.Append([]string{"Packaged", optionalBool(m.IsPackaged)})
+	table.Append([]string{"Created At", style.Time(m.CreatedAt.Local())})
+	table.Append([]string{"Updated At", style.Time(m.UpdatedAt.Local())})
+
+	table.Render()
+}
+
 // PrintTestPlanSummaries renders a list of test plan summaries in a table.
 func PrintTestPlanSummaries(plans []model.CliTestPlanSummary) {
 	table := style.NewTable([]string{"Plan ID", "Name", "Status", "Created At"})
@@ -78,6 +115,34 @@ func PrintTestPlanSummaries(plans []model.CliTestPlanSummary) {
 	table.Render()
 }
 
+// PrintPlanDeletionImpact renders the plans about to be deleted with the number
+// of components and execution results that will be removed with each.
+func PrintPlanDeletionImpact(plans []*model.CliTestPlan) {
+	table := style.NewTable([]string{"Plan ID", "Name", "Status", "Created At", "Components", "Results"})
+
+	for _, p := range plans {
+		status := p.Status
+		if strings.Contains(status, "FAILED") {
+			status = style.Red(status)
+		} else if strings.Contains(status, "COMPLETED") {
+			status = style.Green(status)
+		} else {
+			status = style.Yellow(status)
+		}
+
+		table.Append([]string{
+			style.ID(p.ID),
+			p.Name,
+			status,
+			style.Time(p.CreatedAt.Local()),
+			fmt.Sprintf("%d", len(p.PlanComponents)),
+			fmt.Sprintf("%d", len(p.EnrichedResults())),
+		})
+	}
+
+	table.Render()
+}
+
 // PrintTestPlanDetails renders the full details of a single test plan across multiple tables.
 func PrintTestPlanDetails(plan *model.CliTestPlan) {
 	// --- Plan Summary Table ---
@@ -343,3 +408,142 @@ func PrintExecutionResults(results []model.CliEnrichedTestExecutionResult) {
 
 	table.Render()
 }
+
+// PrintMappingSyncPlan renders the operations in a mapping sync plan, in the
+// style of a terraform plan.
+func PrintMappingSyncPlan(plan mappingsync.Plan) {
+	for _, c := range plan.Creates {
+		fmt.Fprintf(color.Output, "  %s %s\n", style.Green("+ create"), mappingsync.KeyOfRequest(c))
+		if c.MainComponentName != nil {
+			fmt.Fprintf(color.Output, "      %s\n", style.Green(fmt.Sprintf("mainComponentName: %q", *c.MainComponentName)))
+		}
+		if c.TestComponentName != nil {
+			fmt.Fprintf(color.Output, "      %s\n", style.Green(fmt.Sprintf("testComponentName: %q", *c.TestComponentName)))
+		}
+		if c.IsDeployed != nil {
+			fmt.Fprintf(color.Output, "      %s\n", style.Green(fmt.Sprintf("isDeployed: %t", *c.IsDeployed)))
+		}
+		if c.IsPackaged != nil {
+			fmt.Fprintf(color.Output, "      %s\n", style.Green(fmt.Sprintf("isPackaged: %t", *c.IsPackaged)))
+		}
+	}
+
+	for _, u := range plan.Updates {
+		fmt.Fprintf(color.Output, "  %s %s %s\n", style.Yellow("~ update"), mappingsync.KeyOf(u.Existing), style.Faint("(", u.Existing.ID, ")"))
+		for _, ch := range u.Changes {
+			fmt.Fprintf(color.Output, "      %s\n", style.Yellow(fmt.Sprintf("%s: %s => %s", ch.Field, ch.From, ch.To)))
+		}
+	}
+
+	for _, d := range plan.Deletes {
+		fmt.Fprintf(color.Output, "  %s %s %s\n", style.Red("- delete"), mappingsync.KeyOf(d), style.Faint("(", d.ID, ")"))
+	}
+
+	if plan.HasChanges() {
+		fmt.Fprintln(color.Output)
+	}
+	fmt.Fprintf(color.Output, "%s %d to create, %d to update, %d to delete, %d unchanged.\n",
+		style.Bold("Plan:"), len(plan.Creates), len(plan.Updates), len(plan.Deletes), plan.Unchanged)
+}
+
+// PrintMappingFindings renders the findings from a mapping validation in a table.
+func PrintMappingFindings(findings []mappingcheck.Finding) {
+	table := style.NewTable([]string{"Severity", "Check", "Mapping", "Problem", "Remediation"})
+
+	for _, f := range findings {
+		severity := style.Yellow(string(f.Severity))
+		if f.Severity == mappingcheck.SeverityError {
+			severity = style.Red(string(f.Severity))
+		}
+		mapping := fmt.Sprintf("%s\n%s %s\n(%s)", f.MainComponentID, style.IconArrow, f.TestComponentID, style.ID(f.MappingID))
+		table.Append([]string{severity, f.Check, mapping, f.Message, style.Faint(f.Remediation)})
+	}
+
+	table.Render()
+}
+
+// PrintMappingMatrix renders a mapping coverage matrix: coverage per main
+// component, shared test components and orphaned test components.
+func PrintMappingMatrix(matrix mappingmatrix.Matrix) {
+	fmt.Fprintln(color.Output)
+	fmt.Fprintln(color.Output, style.Header("Main Component Coverage"))
+	mainTable := style.NewTable([]string{"Component ID", "Component Name", "Coverage", "Tests", "In Recent Plans"})
+	for _, m := range matrix.MainComponents {
+		coverage := m.Coverage
+		switch m.Coverage {
+		case mappingmatrix.CoverageNone:
+			coverage = style.Red(coverage)
+		case mappingmatrix.CoverageSingle:
+			coverage = style.Yellow(coverage)
+		default:
+			coverage = style.Green(coverage)
+		}
+		mainTable.Append([]string{
+			m.ComponentID,
+			orNA(m.ComponentName),
+			coverage,
+			fmt.Sprintf("%d", m.TestCount),
+			yesNo(m.InRecentPlans),
+		})
+	}
+	mainTable.Render()
+
+	var shared, orphans []mappingmatrix.TestComponent
+	for _, t := range matrix.TestComponents {
+		if t.Shared {
+			shared = append(shared, t)
+		}
+		if t.Orphan {
+			orphans = append(orphans, t)
+		}
+	}
+
+	fmt.Fprintln(color.Output)
+	fmt.Fprintln(color.Output, style.Header(fmt.Sprintf("Shared Test Components (mapped to %d or more main components)", matrix.Summary.SharedThreshold)))
+	if len(shared) == 0 {
+		fmt.Fprintln(color.Output, style.Faint("None"))
+	} else {
+		sharedTable := style.NewTable([]string{"Test Component ID", "Test Component Name", "Main Components", "Main Component IDs"})
+		for _, t := range shared {
+			sharedTable.Append([]string{t.ComponentID, orNA(t.ComponentName), fmt.Sprintf("%d", t.MainCount), strings.Join(t.MainComponentIDs, "\n")})
+		}
+		sharedTable.Render()
+	}
+
+	fmt.Fprintln(color.Output)
+	fmt.Fprintln(color.Output, style.Header("Orphaned Test Components (main components not in recent plans)"))
+	switch {
+	case matrix.Summary.PlansConsidered == 0:
+		fmt.Fprintln(color.Output, style.Faint("Not checked: no plans in the selected window."))
+	case len(orphans) == 0:
+		fmt.Fprintln(color.Output, style.Faint("None"))
+	default:
+		orphanTable := style.NewTable([]string{"Test Component ID", "Test Component Name", "Main Component IDs"})
+		for _, t := range orphans {
+			orphanTable.Append([]string{t.ComponentID, orNA(t.ComponentName), strings.Join(t.MainComponentIDs, "\n")})
+		}
+		orphanTable.Render()
+	}
+
+	s := matrix.Summary
+	fmt.Fprintln(color.Output)
+	fmt.Fprintf(color.Output, "%s %d main component(s): %s uncovered, %s with one test, %s with several.\n",
+		style.Bold("Summary:"), s.MainComponents,
+		style.Red(fmt.Sprintf("%d", s.Uncovered)), style.Yellow(fmt.Sprintf("%d", s.SingleTest)), style.Green(fmt.Sprintf("%d", s.MultipleTests)))
+	fmt.Fprintf(color.Output, "         %d test component(s): %d shared, %d orphaned. Based on %d mapping(s) and %d plan(s).\n",
+		s.TestComponents, s.SharedTests, s.OrphanTests, s.Mappings, s.PlansConsidered)
+}
+
+func orNA(s string) string {
+	if s == "" {
+		return "N/A"
+	}
+	return s
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
@@ -0,0 +1,428 @@
+// automated-test-orchestrator-cli/internal/doctor/doctor.go
+package doctor
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/client"
+	"github.com/automated-test-orchestrator/cli-go/internal/display"
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+)
+
+// Check names, in the order they are run.
+const (
+	CheckConfigFile    = "Config file"
+	CheckConfigWrite   = "Config permissions"
+	CheckAPIURL        = "API URL"
+	CheckReachability  = "API reachability"
+	CheckLatency       = "Latency"
+	CheckTLS           = "TLS"
+	CheckServerVersion = "Server version"
+	CheckProfiles      = "Credential profiles"
+)
+
+const (
+	// latencySamples requests are timed, and the median reported.
+	latencySamples = 3
+	// slowLatency is where polling commands start to feel sluggish.
+	slowLatency = time.Second
+	// certExpiryWarning is how close to expiry a server certificate is flagged.
+	certExpiryWarning = 14 * 24 * time.Hour
+)
+
+// Options describes the configuration to diagnose.
+type Options struct {
+	ConfigFile        string // The config file in use, or "" when none was found
+	ConfigErr         error  // Why the config file could not be read, if it couldn't
+	DefaultConfigFile string // ~/.ato.yaml, which 'ato config set' writes
+
+	APIURL       string
+	APIURLSource string   // Where the API URL came from, e.g. "the --api-url flag"
+	Overridden   []string // Lower-precedence sources that also set the API URL
+
+	APIVersion string // The API contract version this CLI speaks, e.g. "v1"
+	Profile    string // A credential profile that must exist; optional
+	Timeout    time.Duration
+
+	// Progress, when set, is told which check is running.
+	Progress func(check string)
+}
+
+// Report is the outcome of all checks, in the form pasted into support tickets.
+type Report struct {
+	CLIVersion string                `json:"cliVersion"`
+	Platform   string                `json:"platform"`
+	APIURL     string                `json:"apiUrl"`
+	Checks     []display.CheckResult `json:"checks"`
+	Failed     int                   `json:"failed"`
+	Warnings   int                   `json:"warnings"`
+}
+
+// Run performs every check in order, skipping those that depend on an earlier failure.
+func Run(opts Options) []display.CheckResult {
+	progress := func(check string) {
+		if opts.Progress != nil {
+			opts.Progress(check)
+		}
+	}
+	skip := func(checks []display.CheckResult, reason string, names ...string) []display.CheckResult {
+		for _, name := range names {
+			checks = append(checks, display.CheckResult{Name: name, Status: display.CheckSkip, Details: reason})
+		}
+		return checks
+	}
+
+	progress(CheckConfigFile)
+	checks := []display.CheckResult{
+		checkConfigFile(opts),
+		checkConfigWritable(opts.DefaultConfigFile),
+	}
+
+	progress(CheckAPIURL)
+	urlCheck, apiURL := checkAPIURL(opts)
+	checks = append(checks, urlCheck)
+	if apiURL == nil {
+		return skip(checks, "No valid API URL", CheckReachability, CheckLatency, CheckTLS, CheckServerVersion, CheckProfiles)
+	}
+
+	apiClient := &client.APIClient{BaseURL: opts.APIURL, HTTPClient: &http.Client{Timeout: opts.Timeout}}
+
+	progress(CheckReachability)
+	start := time.Now()
+	version, versionErr := apiClient.GetServerVersion()
+	firstLatency := time.Since(start)
+
+	var netErr *client.NetworkError
+	reachable := !errors.As(versionErr, &netErr)
+	if reachable {
+		checks = append(checks, display.CheckResult{Name: CheckReachability, Status: display.CheckPass,
+			Details: fmt.Sprintf("Connected to %s", apiURL.Host)})
+		progress(CheckLatency)
+		checks = append(checks, checkLatency(apiClient, firstLatency))
+	} else {
+		cause := errors.Unwrap(netErr.Err) // Drop the request method and URL
+		if cause == nil {
+			cause = netErr.Err
+		}
+		checks = append(checks, display.CheckResult{Name: CheckReachability, Status: display.CheckFail,
+			Details: fmt.Sprintf("Could not connect to %s: %v", apiURL.Host, cause),
+			Hint:    networkHint(netErr.Err, apiURL, opts.Timeout)})
+		checks = skip(checks, "API not reachable", CheckLatency)
+	}
+
+	progress(CheckTLS)
+	checks = append(checks, checkTLS(apiURL, reachable, opts.Timeout))
+	if !reachable {
+		return skip(checks, "API not reachable", CheckServerVersion, CheckProfiles)
+	}
+
+	checks = append(checks, checkServerVersion(version, versionErr, opts.APIVersion))
+
+	progress(CheckProfiles)
+	return append(checks, checkProfiles(apiClient, opts.Profile))
+}
+
+func checkConfigFile(opts Options) display.CheckResult {
+	result := display.CheckResult{Name: CheckConfigFile}
+	switch {
+	case opts.ConfigErr != nil:
+		result.Status = display.CheckFail
+		result.Details = fmt.Sprintf("Could not read %s: %v", opts.ConfigFile, opts.ConfigErr)
+		result.Hint = "Fix the YAML syntax, or remove the file and run 'ato config set <url>' to recreate it."
+	case opts.ConfigFile == "":
+		result.Status = display.CheckWarn
+		result.Details = "No config file found; using flags, ATO_* environment variables and defaults"
+		result.Hint = fmt.Sprintf("Run 'ato config set <url>' to save the API URL to %s.", opts.DefaultConfigFile)
+	case opts.ConfigFile != opts.DefaultConfigFile && fileExists(opts.DefaultConfigFile):
+		result.Status = display.CheckWarn
+		result.Details = fmt.Sprintf("Using %s, which takes precedence over %s", opts.ConfigFile, opts.DefaultConfigFile)
+		result.Hint = fmt.Sprintf("'ato config set' writes %s but its changes won't be seen; merge the settings into one file and remove the other.", opts.DefaultConfigFile)
+	default:
+		result.Status = display.CheckPass
+		result.Details = fmt.Sprintf("Using %s", opts.ConfigFile)
+	}
+	return result
+}
+
+// checkConfigWritable checks that 'ato config set' can write path, or create it
+// when it doesn't exist yet.
+func checkConfigWritable(path string) display.CheckResult {
+	result := display.CheckResult{Name: CheckConfigWrite}
+
+	info, err := os.Stat(path)
+	switch {
+	case err == nil && info.IsDir():
+		result.Status = display.CheckFail
+		result.Details = fmt.Sprintf("%s is a directory", path)
+		result.Hint = "Move the directory out of the way so the config file can be written."
+	case err == nil:
+		file, err := os.OpenFile(path, os.O_WRONLY, 0)
+		if err != nil {
+			result.Status = display.CheckFail
+			result.Details = fmt.Sprintf("%s is not writable: %v", path, err)
+			result.Hint = fmt.Sprintf("Run 'chmod u+w %s', or fix its owner, so 'ato config set' can save settings.", path)
+			break
+		}
+		file.Close()
+		result.Status = display.CheckPass
+		result.Details = fmt.Sprintf("%s is writable (mode %s)", path, info.Mode().Perm())
+	case errors.Is(err, os.ErrNotExist):
+		dir := filepath.Dir(path)
+		probe, err := os.CreateTemp(dir, ".ato-doctor-*")
+		if err != nil {
+			result.Status = display.CheckFail
+			result.Details = fmt.Sprintf("%s does not exist and %s is not writable: %v", path, dir, err)
+			result.Hint = fmt.Sprintf("Make %s writable so 'ato config set' can create the config file.", dir)
+			break
+		}
+		probe.Close()
+		os.Remove(probe.Name())
+		result.Status = display.CheckPass
+		result.Details = fmt.Sprintf("%s does not exist yet, but can be created", path)
+	default:
+		result.Status = display.CheckFail
+		result.Details = fmt.Sprintf("Could not inspect %s: %v", path, err)
+		result.Hint = "Check the permissions of your home directory."
+	}
+	return result
+}
+
+// checkAPIURL validates the configured API URL and reports where it came from.
+// The parsed URL is nil when it is unusable.
+func checkAPIURL(opts Options) (display.CheckResult, *url.URL) {
+	result := display.CheckResult{Name: CheckAPIURL}
+	source := "from " + opts.APIURLSource
+	if len(opts.Overridden) > 0 {
+		source += ", overriding " + strings.Join(opts.Overridden, " and ")
+	}
+
+	apiURL, err := url.Parse(opts.APIURL)
+	if err != nil || (apiURL.Scheme != "http" && apiURL.Scheme != "https") || apiURL.Host == "" {
+		result.Status = display.CheckFail
+		result.Details = fmt.Sprintf("%q (%s) is not an http:// or https:// URL", opts.APIURL, source)
+		result.Hint = "Set a full URL such as http://localhost:3001/api/v1 with 'ato config set <url>', ATO_API_URL or --api-url."
+		return result, nil
+	}
+
+	result.Details = fmt.Sprintf("%s (%s)", opts.APIURL, source)
+	if !strings.HasSuffix(strings.TrimSuffix(apiURL.Path, "/"), "/api/"+opts.APIVersion) {
+		result.Status = display.CheckWarn
+		result.Hint = fmt.Sprintf("The API serves its routes under /api/%s; the URL usually ends with it, e.g. http://localhost:3001/api/%s.", opts.APIVersion, opts.APIVersion)
+		return result, apiURL
+	}
+	result.Status = display.CheckPass
+	return result, apiURL
+}
+
+// checkLatency times a few more requests and reports the median round trip,
+// together with the first, which also paid for connecting.
+func checkLatency(apiClient *client.APIClient, first time.Duration) display.CheckResult {
+	samples := []time.Duration{first}
+	for len(samples) < latencySamples {
+		start := time.Now()
+		var netErr *client.NetworkError
+		if _, err := apiClient.GetServerVersion(); errors.As(err, &netErr) {
+			break
+		}
+		samples = append(samples, time.Since(start))
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	median := samples[len(samples)/2]
+
+	result := display.CheckResult{Name: CheckLatency, Status: display.CheckPass,
+		Details: fmt.Sprintf("%s median round trip over %d requests (first %s)", roundDuration(median), len(samples), roundDuration(first))}
+	if median >= slowLatency {
+		result.Status = display.CheckWarn
+		result.Hint = "Requests are slow enough to make polling sluggish; check the network path (VPN, proxy) and the server's load."
+	}
+	return result
+}
+
+// checkTLS inspects the server certificate of https URLs, and flags plain HTTP
+// to anything but the local machine.
+func checkTLS(apiURL *url.URL, reachable bool, timeout time.Duration) display.CheckResult {
+	result := display.CheckResult{Name: CheckTLS}
+	host := apiURL.Hostname()
+
+	if apiURL.Scheme == "http" {
+		if isLoopback(host) {
+			result.Status = display.CheckSkip
+			result.Details = "Not used; plain HTTP to the local machine"
+			return result
+		}
+		result.Status = display.CheckWarn
+		result.Details = fmt.Sprintf("Plain HTTP to %s; requests, including credential passwords and tokens, are not encrypted", host)
+		result.Hint = "Serve the API over HTTPS and switch to an https:// API URL."
+		return result
+	}
+
+	address := apiURL.Host
+	if apiURL.Port() == "" {
+		address = net.JoinHostPort(host, "443")
+	}
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", address, &tls.Config{ServerName: host})
+	if err != nil {
+		if !isCertificateError(err) && !reachable {
+			result.Status = display.CheckSkip
+			result.Details = "API not reachable"
+			return result
+		}
+		result.Status = display.CheckFail
+		result.Details = fmt.Sprintf("TLS handshake with %s failed: %v", address, err)
+		result.Hint = "Check that the server's certificate covers this host name and is issued by a CA your system trusts; install the CA if it is private."
+		return result
+	}
+	defer conn.Close()
+
+	state := conn.ConnectionState()
+	leaf := state.PeerCertificates[0]
+	result.Details = fmt.Sprintf("%s, certificate for %s issued by %s, valid until %s",
+		tls.VersionName(state.Version), leaf.Subject.CommonName, leaf.Issuer.CommonName, leaf.NotAfter.Format("2006-01-02"))
+	if time.Until(leaf.NotAfter) < certExpiryWarning {
+		result.Status = display.CheckWarn
+		result.Hint = "The certificate expires soon; renew it before clients start rejecting the server."
+		return result
+	}
+	result.Status = display.CheckPass
+	return result
+}
+
+func checkServerVersion(version *model.CliServerVersion, err error, apiVersion string) display.CheckResult {
+	result := display.CheckResult{Name: CheckServerVersion}
+
+	var apiErr *client.APIError
+	switch {
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+		result.Status = display.CheckWarn
+		result.Details = "The server does not report its version; it predates the version endpoint, or the URL is not the orchestrator API"
+		result.Hint = "Check the API URL, and upgrade the API so the CLI can verify compatibility."
+	case err != nil:
+		result.Status = display.CheckFail
+		result.Details = fmt.Sprintf("Could not read the server version: %v", err)
+		result.Hint = "Check that the API URL points at the orchestrator API and that the server is healthy."
+	case version.APIVersion != apiVersion:
+		result.Status = display.CheckFail
+		result.Details = fmt.Sprintf("Server %s serves API %s, but this CLI speaks API %s", version.Version, version.APIVersion, apiVersion)
+		result.Hint = "Install a CLI release that matches the server, or upgrade the server."
+	default:
+		result.Status = display.CheckPass
+		result.Details = fmt.Sprintf("Server %s serves API %s", version.Version, version.APIVersion)
+	}
+	return result
+}
+
+// checkProfiles checks that credential profiles are saved, and that profile
+// exists when one is given.
+func checkProfiles(apiClient *client.APIClient, profile string) display.CheckResult {
+	result := display.CheckResult{Name: CheckProfiles}
+
+	profiles, err := apiClient.ListCredentialProfiles()
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		result.Status = display.CheckFail
+		result.Details = fmt.Sprintf("The server has no %s/credentials endpoint", apiClient.BaseURL)
+		result.Hint = "Check that the API URL points at the orchestrator API, e.g. http://localhost:3001/api/v1."
+		return result
+	}
+	if err != nil {
+		result.Status = display.CheckFail
+		result.Details = fmt.Sprintf("Could not list profiles: %v", err)
+		result.Hint = "Check the API's logs; the credential store may be unavailable."
+		return result
+	}
+
+	names := make([]string, 0, len(profiles))
+	for _, p := range profiles {
+		names = append(names, p.ProfileName)
+	}
+
+	if profile != "" {
+		for _, p := range profiles {
+			if p.ProfileName == profile {
+				result.Status = display.CheckPass
+				result.Details = fmt.Sprintf("Profile %q found (account %s, user %s)", profile, p.Credentials.AccountID, p.Credentials.Username)
+				return result
+			}
+		}
+		result.Status = display.CheckFail
+		result.Details = fmt.Sprintf("Profile %q not found; saved profiles: %s", profile, orNone(names))
+		result.Hint = fmt.Sprintf("Add it with 'ato creds add %s', or pass a saved profile to --creds.", profile)
+		return result
+	}
+
+	if len(profiles) == 0 {
+		result.Status = display.CheckFail
+		result.Details = "No credential profiles are saved"
+		result.Hint = "Add one with 'ato creds add <profile>'; discover and execute need it."
+		return result
+	}
+	result.Status = display.CheckPass
+	result.Details = fmt.Sprintf("%d saved: %s", len(profiles), orNone(names))
+	return result
+}
+
+// networkHint suggests a fix for an error reaching the API.
+func networkHint(err error, apiURL *url.URL, timeout time.Duration) string {
+	var dnsErr *net.DNSError
+	var netErr net.Error
+	switch {
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return fmt.Sprintf("Nothing is listening on %s; start the API (e.g. 'docker compose up') or fix the port in the API URL.", apiURL.Host)
+	case errors.As(err, &dnsErr):
+		return fmt.Sprintf("The host name %s could not be resolved; check its spelling, your DNS and your VPN.", apiURL.Hostname())
+	case isCertificateError(err):
+		return "The server's certificate was rejected; see the TLS check."
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return fmt.Sprintf("No response within %s; check firewalls, proxies and VPN, or raise --timeout.", timeout)
+	default:
+		return "Check that the API URL is correct and the server is running."
+	}
+}
+
+func isCertificateError(err error) bool {
+	var verifyErr *tls.CertificateVerificationError
+	var authorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidErr x509.CertificateInvalidError
+	return errors.As(err, &verifyErr) || errors.As(err, &authorityErr) || errors.As(err, &hostnameErr) || errors.As(err, &invalidErr)
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// roundDuration keeps durations readable: whole milliseconds, or 10ms steps past a second.
+func roundDuration(d time.Duration) time.Duration {
+	if d >= time.Second {
+		return d.Round(10 * time.Millisecond)
+	}
+	return d.Round(time.Millisecond)
+}
+
+func orNone(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+	return strings.Join(values, ", ")
+}
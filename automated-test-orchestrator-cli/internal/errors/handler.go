@@ -11,6 +11,10 @@ import (
 	"github.com/briandowns/spinner"
 )
 
+// Exit terminates the process with the given status. Commands exit through it
+// rather than os.Exit so 'ato shell' can carry on after a command fails.
+var Exit = os.Exit
+
 // FormatError inspects an error and creates a user-friendly, formatted string.
 func FormatError(err error) string {
 	var apiErr *client.APIError
@@ -38,5 +42,5 @@ func HandleCLIError(s *spinner.Spinner, err error) {
 	errorMessage := FormatError(err)
 	fmt.Fprintln(os.Stderr) // Add a newline before the error for better visibility
 	style.Error(errorMessage)
-	os.Exit(1)
+	Exit(1)
 }
@@ -3,7 +3,7 @@ package export
 
 import (
 	"encoding/csv"
-	"os"
+	"io"
 	"time"
 
 	"github.com/automated-test-orchestrator/cli-go/internal/model"
@@ -12,16 +12,9 @@ import (
 // CSVExporter implements the Exporter interface for CSV format.
 type CSVExporter struct{}
 
-// Export writes the results to a CSV file.
-func (e *CSVExporter) Export(results []model.CliEnrichedTestExecutionResult, filePath string) error {
-	file, err := os.Create(filePath)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
+// Export writes the results as CSV, one row per test case.
+func (e *CSVExporter) Export(w io.Writer, results []model.CliEnrichedTestExecutionResult) error {
+	writer := csv.NewWriter(w)
 
 	// Write Header
 	header := []string{
@@ -79,7 +72,8 @@ func (e *CSVExporter) Export(results []model.CliEnrichedTestExecutionResult, fil
 		}
 	}
 
-	return nil
+	writer.Flush()
+	return writer.Error()
 }
 
 func safeString(s *string) string {
@@ -1,9 +1,9 @@
 // automated-test-orchestrator-cli/internal/export/exporter.go
-// automated-test-orchestrator-cli/internal/export/exporter.go
 package export
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/automated-test-orchestrator/cli-go/internal/model"
@@ -11,20 +11,42 @@ import (
 
 // Exporter defines the interface for exporting test execution results.
 type Exporter interface {
-	Export(results []model.CliEnrichedTestExecutionResult, filePath string) error
+	Export(w io.Writer, results []model.CliEnrichedTestExecutionResult) error
+}
+
+// SupportedFormats lists the export formats accepted by NewExporter.
+var SupportedFormats = []string{"json", "csv", "xml", "junit", "html", "markdown"}
+
+// IsSupportedFormat reports whether NewExporter accepts the format.
+func IsSupportedFormat(format string) bool {
+	_, err := NewExporter(format)
+	return err == nil
 }
 
 // NewExporter creates a new Exporter based on the specified format.
-// Supported formats: "json", "csv", "xml".
+// Supported formats: "json", "csv", "xml" (also accepted as "junit"), "html",
+// "markdown" (also accepted as "md").
 func NewExporter(format string) (Exporter, error) {
 	switch strings.ToLower(format) {
 	case "json":
 		return &JSONExporter{}, nil
 	case "csv":
 		return &CSVExporter{}, nil
-	case "xml":
+	case "xml", "junit":
 		return &XMLExporter{}, nil
+	case "html":
+		return &HTMLExporter{}, nil
+	case "markdown", "md":
+		return &MarkdownExporter{}, nil
 	default:
 		return nil, fmt.Errorf("unsupported export format: %s", format)
 	}
 }
+
+// ExportToPath runs the exporter against the given path, where "-" means stdout.
+// See WriteOutput for how files are written.
+func ExportToPath(e Exporter, results []model.CliEnrichedTestExecutionResult, path string) error {
+	return WriteOutput(path, func(w io.Writer) error {
+		return e.Export(w, results)
+	})
+}
@@ -0,0 +1,181 @@
+// automated-test-orchestrator-cli/internal/export/html.go
+package export
+
+import (
+	"html/template"
+	"io"
+	"time"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+)
+
+// HTMLExporter implements the Exporter interface for a self-contained HTML report.
+type HTMLExporter struct{}
+
+type htmlCase struct {
+	Label   string
+	Passed  bool
+	Skipped bool // Neither passed nor failed
+	Details string
+}
+
+type htmlTest struct {
+	Name       string
+	Passed     bool
+	Message    string
+	ExecutedAt string
+	Cases      []htmlCase
+}
+
+type htmlComponent struct {
+	Name  string
+	Tests []*htmlTest
+}
+
+type htmlPlan struct {
+	ID         string
+	Name       string
+	Passed     bool
+	Components []*htmlComponent
+}
+
+type htmlReport struct {
+	GeneratedAt                          string
+	TestsTotal, TestsPassed, TestsFailed int
+	CasesTotal, CasesPassed, CasesFailed int
+	CasesSkipped                         int
+	Plans                                []*htmlPlan
+}
+
+var htmlReportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Test Execution Report</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #222; }
+h1 { margin-bottom: 0.2em; }
+.meta { color: #777; margin-bottom: 1.5em; }
+.summary td { padding: 0.2em 1em 0.2em 0; }
+.badge { display: inline-block; padding: 0.1em 0.5em; border-radius: 3px; color: #fff; font-weight: bold; font-size: 0.8em; }
+.pass { background: #2e7d32; }
+.fail { background: #c62828; }
+.ok { color: #2e7d32; }
+.ko { color: #c62828; }
+.skip { color: #777; }
+.component { margin: 1em 0 0.5em 1em; font-weight: bold; }
+.test { margin-left: 2em; }
+.case { margin-left: 3.5em; }
+pre { margin: 0.2em 0 0.5em 4em; color: #555; white-space: pre-wrap; }
+</style>
+</head>
+<body>
+<h1>Test Execution Report</h1>
+<div class="meta">Generated {{.GeneratedAt}}</div>
+<table class="summary">
+<tr><td>Tests</td><td class="ko">{{.TestsFailed}} failed</td><td class="ok">{{.TestsPassed}} passed</td><td>{{.TestsTotal}} total</td></tr>
+<tr><td>Test Cases</td><td class="ko">{{.CasesFailed}} failed</td><td class="ok">{{.CasesPassed}} passed</td><td class="skip">{{.CasesSkipped}} skipped</td><td>{{.CasesTotal}} total</td></tr>
+</table>
+{{range .Plans}}
+<h2>{{if .Passed}}<span class="badge pass">PASS</span>{{else}}<span class="badge fail">FAIL</span>{{end}} {{.Name}}</h2>
+<div class="meta">Plan ID: {{.ID}}</div>
+{{range .Components}}
+<div class="component">&#128230; {{.Name}}</div>
+{{range .Tests}}
+<div class="test {{if .Passed}}ok{{else}}ko{{end}}">{{.Name}} <span class="meta">{{.ExecutedAt}}</span></div>
+{{if .Cases}}{{range .Cases}}
+{{if .Skipped}}<div class="case skip">&ndash; {{.Label}} (skipped)</div>{{else}}<div class="case {{if .Passed}}ok{{else}}ko{{end}}">{{if .Passed}}&#10003;{{else}}&#10007;{{end}} {{.Label}}</div>{{end}}
+{{if .Details}}<pre>{{.Details}}</pre>{{end}}
+{{end}}{{else}}
+<div class="case {{if .Passed}}ok{{else}}ko{{end}}">{{if .Passed}}&#10003; Test completed successfully{{else}}&#10007; Test Failed{{end}}</div>
+{{if .Message}}<pre>{{.Message}}</pre>{{end}}
+{{end}}
+{{end}}
+{{end}}
+{{end}}
+</body>
+</html>
+`))
+
+// Export writes the results as a single HTML page grouped by plan and component.
+func (e *HTMLExporter) Export(w io.Writer, results []model.CliEnrichedTestExecutionResult) error {
+	report := htmlReport{GeneratedAt: time.Now().Format(time.RFC3339)}
+
+	plans := make(map[string]*htmlPlan)
+	components := make(map[string]*htmlComponent)
+
+	for _, r := range results {
+		plan, ok := plans[r.TestPlanID]
+		if !ok {
+			plan = &htmlPlan{ID: r.TestPlanID, Name: r.TestPlanID, Passed: true}
+			if name := safeString(r.TestPlanName); name != "" {
+				plan.Name = name
+			}
+			plans[r.TestPlanID] = plan
+			report.Plans = append(report.Plans, plan)
+		}
+
+		compKey := r.TestPlanID + "/" + r.PlanComponentID
+		comp, ok := components[compKey]
+		if !ok {
+			comp = &htmlComponent{Name: r.PlanComponentID}
+			if name := safeString(r.ComponentName); name != "" {
+				comp.Name = name
+			}
+			components[compKey] = comp
+			plan.Components = append(plan.Components, comp)
+		}
+
+		test := &htmlTest{
+			Name:       r.TestComponentID,
+			Passed:     true,
+			Message:    safeString(r.Message),
+			ExecutedAt: r.ExecutedAt.Format(time.RFC3339),
+		}
+		if name := safeString(r.TestComponentName); name != "" {
+			test.Name = name
+		}
+
+		if len(r.TestCases) > 0 {
+			for _, tc := range r.TestCases {
+				outcome := caseOutcome(tc)
+				test.Cases = append(test.Cases, htmlCase{
+					Label:   junitCaseName(tc),
+					Passed:  outcome == casePassed,
+					Skipped: outcome == caseSkipped,
+					Details: safeString(tc.Details),
+				})
+				report.CasesTotal++
+				switch outcome {
+				case casePassed:
+					report.CasesPassed++
+				case caseFailed:
+					report.CasesFailed++
+					test.Passed = false
+				default:
+					report.CasesSkipped++
+				}
+			}
+		} else {
+			report.CasesTotal++
+			if r.Status == "FAILURE" {
+				report.CasesFailed++
+				test.Passed = false
+			} else {
+				report.CasesPassed++
+			}
+		}
+
+		report.TestsTotal++
+		if test.Passed {
+			report.TestsPassed++
+		} else {
+			report.TestsFailed++
+			plan.Passed = false
+		}
+
+		comp.Tests = append(comp.Tests, test)
+	}
+
+	return htmlReportTemplate.Execute(w, report)
+}
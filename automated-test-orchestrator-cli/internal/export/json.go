@@ -3,7 +3,7 @@ package export
 
 import (
 	"encoding/json"
-	"os"
+	"io"
 
 	"github.com/automated-test-orchestrator/cli-go/internal/model"
 )
@@ -11,15 +11,9 @@ import (
 // JSONExporter implements the Exporter interface for JSON format.
 type JSONExporter struct{}
 
-// Export writes the results to a JSON file.
-func (e *JSONExporter) Export(results []model.CliEnrichedTestExecutionResult, filePath string) error {
-	file, err := os.Create(filePath)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	encoder := json.NewEncoder(file)
+// Export writes the results as indented JSON.
+func (e *JSONExporter) Export(w io.Writer, results []model.CliEnrichedTestExecutionResult) error {
+	encoder := json.NewEncoder(w)
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(results)
 }
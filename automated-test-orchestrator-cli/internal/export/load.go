@@ -0,0 +1,52 @@
+// automated-test-orchestrator-cli/internal/export/load.go
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+)
+
+// LoadJSONResults reads results previously written by JSONExporter. A path of
+// "-" reads from stdin.
+func LoadJSONResults(path string) ([]model.CliEnrichedTestExecutionResult, error) {
+	var r io.Reader
+	if IsStdout(path) {
+		r = os.Stdin
+	} else {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	var results []model.CliEnrichedTestExecutionResult
+	if err := json.NewDecoder(r).Decode(&results); err != nil {
+		return nil, fmt.Errorf("failed to decode %s as exported JSON results: %w", path, err)
+	}
+	return results, nil
+}
+
+// MergeResults concatenates result sets in order, dropping any result whose ID
+// has already been seen so overlapping exports are not double-counted.
+func MergeResults(sets ...[]model.CliEnrichedTestExecutionResult) []model.CliEnrichedTestExecutionResult {
+	seen := make(map[string]bool)
+	var merged []model.CliEnrichedTestExecutionResult
+	for _, set := range sets {
+		for _, r := range set {
+			if r.ID != "" {
+				if seen[r.ID] {
+					continue
+				}
+				seen[r.ID] = true
+			}
+			merged = append(merged, r)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,147 @@
+// automated-test-orchestrator-cli/internal/export/markdown.go
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+)
+
+// MarkdownExporter implements the Exporter interface for Markdown, suitable for
+// pull request comments and CI job summaries.
+type MarkdownExporter struct{}
+
+// Export writes a summary table followed by a results table per plan and the
+// details of every failure.
+func (e *MarkdownExporter) Export(w io.Writer, results []model.CliEnrichedTestExecutionResult) error {
+	var b strings.Builder
+
+	var testsPassed, testsFailed, casesPassed, casesFailed, casesSkipped int
+	var planOrder []string
+	byPlan := make(map[string][]model.CliEnrichedTestExecutionResult)
+
+	for _, r := range results {
+		failed := r.Status == "FAILURE"
+		if len(r.TestCases) > 0 {
+			for _, tc := range r.TestCases {
+				switch caseOutcome(tc) {
+				case casePassed:
+					casesPassed++
+				case caseFailed:
+					casesFailed++
+					failed = true
+				default:
+					casesSkipped++
+				}
+			}
+		} else if failed {
+			casesFailed++
+			failed = true
+		} else {
+			casesPassed++
+		}
+		if failed {
+			testsFailed++
+		} else {
+			testsPassed++
+		}
+
+		if _, ok := byPlan[r.TestPlanID]; !ok {
+			planOrder = append(planOrder, r.TestPlanID)
+		}
+		byPlan[r.TestPlanID] = append(byPlan[r.TestPlanID], r)
+	}
+
+	b.WriteString("# Test Execution Report\n\n")
+	b.WriteString("| | Failed | Passed | Skipped | Total |\n")
+	b.WriteString("|---|---|---|---|---|\n")
+	fmt.Fprintf(&b, "| Tests | %d | %d | - | %d |\n", testsFailed, testsPassed, testsFailed+testsPassed)
+	fmt.Fprintf(&b, "| Test Cases | %d | %d | %d | %d |\n", casesFailed, casesPassed, casesSkipped, casesFailed+casesPassed+casesSkipped)
+
+	for _, planID := range planOrder {
+		planResults := byPlan[planID]
+		planName := safeString(planResults[0].TestPlanName)
+		if planName == "" {
+			planName = planID
+		}
+
+		fmt.Fprintf(&b, "\n## %s\n\n", markdownEscape(planName))
+		fmt.Fprintf(&b, "Plan ID: `%s`\n\n", planID)
+		b.WriteString("| Component | Test | Status | Cases | Executed At |\n")
+		b.WriteString("|---|---|---|---|---|\n")
+
+		var failures []string
+		for _, r := range planResults {
+			component := safeString(r.ComponentName)
+			if component == "" {
+				component = r.PlanComponentID
+			}
+			test := safeString(r.TestComponentName)
+			if test == "" {
+				test = r.TestComponentID
+			}
+
+			failed := r.Status == "FAILURE"
+			caseFailures := 0
+			cases := "-"
+			if len(r.TestCases) > 0 {
+				passed, skipped := 0, 0
+				for _, tc := range r.TestCases {
+					switch caseOutcome(tc) {
+					case casePassed:
+						passed++
+					case caseFailed:
+						failed = true
+						caseFailures++
+						failures = append(failures, fmt.Sprintf("**%s** › %s\n\n%s", markdownEscape(test), markdownEscape(junitCaseName(tc)), markdownCodeBlock(safeString(tc.Details))))
+					default:
+						skipped++
+					}
+				}
+				cases = fmt.Sprintf("%d/%d", passed, len(r.TestCases))
+				if skipped > 0 {
+					cases += fmt.Sprintf(" (%d skipped)", skipped)
+				}
+			}
+			// A failed result with no failing case to show failed at the process level.
+			if failed && caseFailures == 0 {
+				failures = append(failures, fmt.Sprintf("**%s**\n\n%s", markdownEscape(test), markdownCodeBlock(safeString(r.Message))))
+			}
+
+			status := "✅ SUCCESS"
+			if failed {
+				status = "❌ FAILURE"
+			}
+
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n",
+				markdownEscape(component), markdownEscape(test), status, cases, r.ExecutedAt.Format(time.RFC3339))
+		}
+
+		if len(failures) > 0 {
+			b.WriteString("\n### Failures\n")
+			for _, f := range failures {
+				b.WriteString("\n" + f + "\n")
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownEscape keeps user-supplied names from breaking table cells.
+func markdownEscape(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+func markdownCodeBlock(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "_No details provided._"
+	}
+	return "```\n" + s + "\n```"
+}
@@ -0,0 +1,77 @@
+// automated-test-orchestrator-cli/internal/export/output.go
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// StdoutPath is the conventional path argument for writing an export to stdout.
+const StdoutPath = "-"
+
+// IsStdout reports whether the path refers to stdout rather than a file.
+func IsStdout(path string) bool {
+	return path == StdoutPath
+}
+
+// WriteOutput calls write with a writer for the given path. For "-" the data is
+// streamed to stdout. Otherwise it is written to a temporary file in the target
+// directory and renamed into place once complete, so a failed export never leaves
+// a truncated file behind for CI to parse.
+func WriteOutput(path string, write func(w io.Writer) error) error {
+	if IsStdout(path) {
+		buf := bufio.NewWriter(os.Stdout)
+		if err := write(buf); err != nil {
+			return err
+		}
+		return buf.Flush()
+	}
+
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file in %s: %w", dir, err)
+	}
+	tmpPath := tmp.Name()
+
+	// Clean up the temporary file on any failure before the rename.
+	committed := false
+	defer func() {
+		if !committed {
+			tmp.Close()
+			os.Remove(tmpPath)
+		}
+	}()
+
+	buf := bufio.NewWriter(tmp)
+	if err := write(buf); err != nil {
+		return err
+	}
+	if err := buf.Flush(); err != nil {
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	// CreateTemp uses 0600. Keep the mode of a file being replaced, so a report a
+	// user restricted stays restricted; new exports are ordinary files.
+	mode := os.FileMode(0o644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+	if err := os.Chmod(tmpPath, mode); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to move export into place at %s: %w", path, err)
+	}
+
+	committed = true
+	return nil
+}
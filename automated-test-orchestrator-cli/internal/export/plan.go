@@ -0,0 +1,250 @@
+// automated-test-orchestrator-cli/internal/export/plan.go
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+)
+
+// PlanExporter defines the interface for exporting a test plan's coverage inventory.
+type PlanExporter interface {
+	ExportPlan(w io.Writer, plan *model.CliTestPlan) error
+}
+
+// SupportedPlanFormats lists the export formats accepted by NewPlanExporter.
+var SupportedPlanFormats = []string{"json", "csv", "excel"}
+
+// NewPlanExporter creates a new PlanExporter based on the specified format.
+// Supported formats: "json", "csv", and "excel" (CSV that opens cleanly in Excel).
+func NewPlanExporter(format string) (PlanExporter, error) {
+	switch strings.ToLower(format) {
+	case "json":
+		return &PlanJSONExporter{}, nil
+	case "csv":
+		return &PlanCSVExporter{}, nil
+	case "excel":
+		return &PlanCSVExporter{Excel: true}, nil
+	default:
+		return nil, fmt.Errorf("unsupported plan export format: %s", format)
+	}
+}
+
+// FormatFromPath infers an export format from a file extension, returning
+// fallback when the extension is not recognised or the path is stdout.
+func FormatFromPath(path, fallback string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return "json"
+	case ".csv":
+		return "csv"
+	case ".xml":
+		return "xml"
+	case ".html", ".htm":
+		return "html"
+	case ".md":
+		return "markdown"
+	default:
+		return fallback
+	}
+}
+
+// PlanFormatFromPath infers a plan export format from a file extension. Paths
+// without an extension, and stdout, get JSON. Unrecognised extensions are
+// rejected rather than written as JSON under a misleading name.
+func PlanFormatFromPath(path string) (string, error) {
+	if IsStdout(path) {
+		return "json", nil
+	}
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case "", ".json":
+		return "json", nil
+	case ".csv":
+		return "csv", nil
+	case ".xlsx", ".xls":
+		return "", fmt.Errorf("%s workbooks are not supported; export a spreadsheet that opens in Excel with a .csv path and --format excel", ext)
+	default:
+		return "", fmt.Errorf("cannot infer the export format from %q; use a .json or .csv path, or set --format (%s)", ext, strings.Join(SupportedPlanFormats, ", "))
+	}
+}
+
+// PlanInventory is the exported shape of a test plan: its components, the tests
+// available for each one, and the components that have no coverage.
+type PlanInventory struct {
+	ID            string               `json:"id"`
+	Name          string               `json:"name"`
+	PlanType      string               `json:"planType,omitempty"`
+	Status        string               `json:"status"`
+	FailureReason *string              `json:"failureReason,omitempty"`
+	CreatedAt     time.Time            `json:"createdAt"`
+	UpdatedAt     time.Time            `json:"updatedAt"`
+	Summary       PlanInventorySummary `json:"summary"`
+	Components    []ComponentInventory `json:"components"`
+	CoverageGaps  []string             `json:"coverageGaps"`
+}
+
+// PlanInventorySummary holds the headline coverage counts for a plan.
+type PlanInventorySummary struct {
+	Components          int `json:"components"`
+	EntryPoints         int `json:"entryPoints"`
+	CoveredComponents   int `json:"coveredComponents"`
+	UncoveredComponents int `json:"uncoveredComponents"`
+	AvailableTests      int `json:"availableTests"`
+}
+
+// ComponentInventory describes a single plan component and its available tests.
+type ComponentInventory struct {
+	ComponentID    string          `json:"componentId"`
+	ComponentName  *string         `json:"componentName,omitempty"`
+	ComponentType  *string         `json:"componentType,omitempty"`
+	EntryPoint     bool            `json:"entryPoint"`
+	HasCoverage    bool            `json:"hasCoverage"`
+	AvailableTests []TestInventory `json:"availableTests"`
+}
+
+// TestInventory describes an available test and the outcome of its latest execution.
+type TestInventory struct {
+	ID     string  `json:"id"`
+	Name   *string `json:"name,omitempty"`
+	Status string  `json:"status"` // "SUCCESS", "FAILURE" or "NOT_RUN"
+}
+
+// NewPlanInventory builds the inventory for a plan.
+func NewPlanInventory(plan *model.CliTestPlan) PlanInventory {
+	inv := PlanInventory{
+		ID:            plan.ID,
+		Name:          plan.Name,
+		PlanType:      plan.PlanType,
+		Status:        plan.Status,
+		FailureReason: plan.FailureReason,
+		CreatedAt:     plan.CreatedAt,
+		UpdatedAt:     plan.UpdatedAt,
+		Components:    []ComponentInventory{},
+		CoverageGaps:  []string{},
+	}
+
+	for _, c := range plan.PlanComponents {
+		// Results are returned in execution order, so the last one per test wins.
+		statusByTest := make(map[string]string)
+		for _, res := range c.ExecutionResults {
+			statusByTest[res.TestComponentID] = res.Status
+		}
+
+		comp := ComponentInventory{
+			ComponentID:    c.ComponentID,
+			ComponentName:  c.ComponentName,
+			ComponentType:  c.ComponentType,
+			EntryPoint:     plan.IsEntryPoint(c),
+			HasCoverage:    len(c.AvailableTests) > 0,
+			AvailableTests: []TestInventory{},
+		}
+		for _, t := range c.AvailableTests {
+			status, ok := statusByTest[t.ID]
+			if !ok {
+				status = "NOT_RUN"
+			}
+			comp.AvailableTests = append(comp.AvailableTests, TestInventory{ID: t.ID, Name: t.Name, Status: status})
+		}
+
+		inv.Summary.Components++
+		inv.Summary.AvailableTests += len(comp.AvailableTests)
+		if comp.EntryPoint {
+			inv.Summary.EntryPoints++
+		}
+		if comp.HasCoverage {
+			inv.Summary.CoveredComponents++
+		} else {
+			inv.Summary.UncoveredComponents++
+			inv.CoverageGaps = append(inv.CoverageGaps, comp.ComponentID)
+		}
+
+		inv.Components = append(inv.Components, comp)
+	}
+
+	return inv
+}
+
+// PlanJSONExporter implements the PlanExporter interface for JSON format.
+type PlanJSONExporter struct{}
+
+// ExportPlan writes the plan inventory as indented JSON.
+func (e *PlanJSONExporter) ExportPlan(w io.Writer, plan *model.CliTestPlan) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(NewPlanInventory(plan))
+}
+
+// PlanCSVExporter implements the PlanExporter interface for CSV format.
+type PlanCSVExporter struct {
+	// Excel writes a UTF-8 byte order mark and CRLF line endings so the file
+	// opens with the correct encoding when double-clicked in Excel.
+	Excel bool
+}
+
+// ExportPlan writes one row per available test. Components without tests get a
+// single row with empty test columns, so coverage gaps remain visible.
+func (e *PlanCSVExporter) ExportPlan(w io.Writer, plan *model.CliTestPlan) error {
+	if e.Excel {
+		if _, err := io.WriteString(w, "\ufeff"); err != nil {
+			return err
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = e.Excel
+
+	header := []string{
+		"Plan ID",
+		"Plan Name",
+		"Plan Status",
+		"Component ID",
+		"Component Name",
+		"Component Type",
+		"Entry Point",
+		"Has Test Coverage",
+		"Test ID",
+		"Test Name",
+		"Test Status",
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	inv := NewPlanInventory(plan)
+	for _, c := range inv.Components {
+		prefix := []string{
+			inv.ID,
+			inv.Name,
+			inv.Status,
+			c.ComponentID,
+			safeString(c.ComponentName),
+			safeString(c.ComponentType),
+			strconv.FormatBool(c.EntryPoint),
+			strconv.FormatBool(c.HasCoverage),
+		}
+
+		if len(c.AvailableTests) == 0 {
+			if err := writer.Write(append(prefix, "", "", "")); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, t := range c.AvailableTests {
+			row := append(append([]string{}, prefix...), t.ID, safeString(t.Name), t.Status)
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,48 @@
+// automated-test-orchestrator-cli/internal/export/target.go
+package export
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Target is a single export destination, parsed from a "--export format=path" flag.
+type Target struct {
+	Format string
+	Path   string
+}
+
+// ParseTargets parses repeatable export flag values. Each value is either
+// "format=path" or a bare path, in which case defaultFormat is used. Only one
+// target may write to stdout.
+func ParseTargets(values []string, defaultFormat string) ([]Target, error) {
+	var targets []Target
+	stdoutUsed := false
+
+	for _, value := range values {
+		target := Target{Format: defaultFormat, Path: value}
+
+		// Only treat the prefix as a format if it is one we know, so paths that
+		// happen to contain '=' are still accepted as bare paths.
+		if format, path, found := strings.Cut(value, "="); found && IsSupportedFormat(format) {
+			target = Target{Format: format, Path: path}
+		}
+
+		if strings.TrimSpace(target.Path) == "" {
+			return nil, fmt.Errorf("export target '%s' is missing a path", value)
+		}
+		if !IsSupportedFormat(target.Format) {
+			return nil, fmt.Errorf("unsupported export format: %s", target.Format)
+		}
+		if IsStdout(target.Path) {
+			if stdoutUsed {
+				return nil, fmt.Errorf("only one export can be written to stdout")
+			}
+			stdoutUsed = true
+		}
+
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
@@ -1,109 +1,244 @@
 // automated-test-orchestrator-cli/internal/export/xml.go
-// automated-test-orchestrator-cli/internal/export/xml.go
 package export
 
 import (
 	"encoding/xml"
-	"os"
+	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/automated-test-orchestrator/cli-go/internal/model"
 )
 
 // XMLExporter implements the Exporter interface for JUnit XML format.
-type XMLExporter struct{}
+type XMLExporter struct {
+	// CredentialProfile is recorded as a suite property when set. The results
+	// endpoint does not return the profile used, so callers that know it pass it in.
+	CredentialProfile string
+}
 
 type JUnitTestSuites struct {
-	XMLName xml.Name         `xml:"testsuites"`
-	Suites  []JUnitTestSuite `xml:"testsuite"`
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr,omitempty"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []JUnitTestSuite `xml:"testsuite"`
 }
 
 type JUnitTestSuite struct {
-	Name      string          `xml:"name,attr"`
-	Tests     int             `xml:"tests,attr"`
-	Failures  int             `xml:"failures,attr"`
-	Timestamp string          `xml:"timestamp,attr"`
-	Cases     []JUnitTestCase `xml:"testcase"`
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Time       string          `xml:"time,attr"`
+	Timestamp  string          `xml:"timestamp,attr"`
+	Properties []JUnitProperty `xml:"properties>property,omitempty"`
+	Cases      []JUnitTestCase `xml:"testcase"`
+	SystemOut  string          `xml:"system-out,omitempty"`
+}
+
+type JUnitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
 }
 
 type JUnitTestCase struct {
 	Name      string        `xml:"name,attr"`
 	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
 	Failure   *JUnitFailure `xml:"failure,omitempty"`
+	Error     *JUnitFailure `xml:"error,omitempty"`
+	Skipped   *JUnitSkipped `xml:"skipped,omitempty"`
 }
 
+// JUnitFailure is used for both <failure> (an assertion did not hold) and
+// <error> (the test process could not run to completion) elements.
 type JUnitFailure struct {
 	Message string `xml:"message,attr"`
 	Type    string `xml:"type,attr"`
 	Content string `xml:",chardata"`
 }
 
-// Export writes the results to a JUnit XML file.
-func (e *XMLExporter) Export(results []model.CliEnrichedTestExecutionResult, filePath string) error {
-	suites := JUnitTestSuites{}
+type JUnitSkipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+// Export writes the results as a JUnit XML document.
+func (e *XMLExporter) Export(w io.Writer, results []model.CliEnrichedTestExecutionResult) error {
+	suites := e.buildSuites(results)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+
+	// Encode does not terminate the document with a newline.
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// buildSuites maps each execution result to a <testsuite>. Granular test cases
+// become individual <testcase> elements; results without cases are reported as a
+// single "Process Execution" case, which is an <error> rather than a <failure>
+// because there are no assertions to have failed.
+func (e *XMLExporter) buildSuites(results []model.CliEnrichedTestExecutionResult) JUnitTestSuites {
+	suites := JUnitTestSuites{Name: "Automated Test Orchestrator"}
 
 	for _, result := range results {
+		testSuiteName := safeString(result.TestComponentName)
+		if testSuiteName == "" {
+			testSuiteName = result.TestComponentID
+		}
+
 		suite := JUnitTestSuite{
-			Name:      safeString(result.TestComponentName),
-			Timestamp: result.ExecutedAt.Format(time.RFC3339),
+			Name:       testSuiteName,
+			Time:       formatSeconds(0),
+			Timestamp:  result.ExecutedAt.Format(time.RFC3339),
+			Properties: e.suiteProperties(result),
+			SystemOut:  safeString(result.Message),
 		}
 
-		componentName := safeString(result.ComponentName)
+		className := junitClassName(result)
 
 		if len(result.TestCases) == 0 {
-			// Handle legacy/system level results without granular cases
 			suite.Tests = 1
 			testCase := JUnitTestCase{
 				Name:      "Process Execution",
-				ClassName: componentName,
+				ClassName: className,
+				Time:      formatSeconds(0),
 			}
 
 			if result.Status == "FAILURE" {
-				suite.Failures = 1
-				msg := safeString(result.Message)
-				testCase.Failure = &JUnitFailure{
+				suite.Errors = 1
+				testCase.Error = &JUnitFailure{
 					Message: "Process Execution Failed",
-					Type:    "SystemError",
-					Content: msg,
+					Type:    "ExecutionError",
+					Content: safeString(result.Message),
 				}
 			}
 			suite.Cases = append(suite.Cases, testCase)
 		} else {
-			// Handle granular test cases
 			suite.Tests = len(result.TestCases)
 			for _, tc := range result.TestCases {
 				testCase := JUnitTestCase{
-					Name:      tc.TestDescription,
-					ClassName: componentName,
+					Name:      junitCaseName(tc),
+					ClassName: className,
+					Time:      formatSeconds(0),
 				}
 
-				if tc.Status == "FAILED" {
+				switch caseOutcome(tc) {
+				case caseFailed:
 					suite.Failures++
-					details := safeString(tc.Details)
 					testCase.Failure = &JUnitFailure{
 						Message: "Assertion Failed",
 						Type:    "AssertionError",
-						Content: details,
+						Content: safeString(tc.Details),
 					}
+				case caseSkipped:
+					suite.Skipped++
+					testCase.Skipped = &JUnitSkipped{Message: tc.Status}
 				}
 				suite.Cases = append(suite.Cases, testCase)
 			}
 		}
 
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
 		suites.Suites = append(suites.Suites, suite)
 	}
 
-	file, err := os.Create(filePath)
-	if err != nil {
-		return err
+	// The API does not record execution durations, so times are reported as zero.
+	// The attributes are still written because several CI parsers require them.
+	suites.Time = formatSeconds(0)
+
+	return suites
+}
+
+// suiteProperties records the identifiers needed to trace a suite back to the orchestrator.
+func (e *XMLExporter) suiteProperties(result model.CliEnrichedTestExecutionResult) []JUnitProperty {
+	props := []JUnitProperty{
+		{Name: "testPlanId", Value: result.TestPlanID},
+	}
+	if name := safeString(result.TestPlanName); name != "" {
+		props = append(props, JUnitProperty{Name: "testPlanName", Value: name})
 	}
-	defer file.Close()
+	props = append(props, JUnitProperty{Name: "componentId", Value: result.PlanComponentID})
+	if name := safeString(result.ComponentName); name != "" {
+		props = append(props, JUnitProperty{Name: "componentName", Value: name})
+	}
+	props = append(props, JUnitProperty{Name: "testComponentId", Value: result.TestComponentID})
+	if e.CredentialProfile != "" {
+		props = append(props, JUnitProperty{Name: "credentialProfile", Value: e.CredentialProfile})
+	}
+	return props
+}
 
-	encoder := xml.NewEncoder(file)
-	encoder.Indent("", "  ")
-	if err := encoder.Encode(suites); err != nil {
-		return err
+// junitClassName builds a "<plan>.<component>" classname. Jenkins and Azure DevOps
+// split classnames on the last dot, so tests are grouped by plan and then by component.
+func junitClassName(result model.CliEnrichedTestExecutionResult) string {
+	plan := safeString(result.TestPlanName)
+	if plan == "" {
+		plan = result.TestPlanID
 	}
+	component := safeString(result.ComponentName)
+	if component == "" {
+		component = result.PlanComponentID
+	}
+	return classNameSegment(plan) + "." + classNameSegment(component)
+}
+
+// classNameSegment strips characters that CI tools would treat as package separators.
+func classNameSegment(s string) string {
+	s = strings.TrimSpace(s)
+	s = strings.ReplaceAll(s, ".", "_")
+	return strings.Join(strings.Fields(s), "_")
+}
+
+// Test case outcomes, shared by the exporters so every report of a run agrees.
+const (
+	casePassed  = "passed"
+	caseFailed  = "failed"
+	caseSkipped = "skipped"
+)
+
+// caseOutcome classifies a test case. Anything that is neither PASSED nor FAILED
+// did not produce a verdict, and is reported as skipped.
+func caseOutcome(tc model.TestCaseResult) string {
+	switch tc.Status {
+	case "PASSED":
+		return casePassed
+	case "FAILED":
+		return caseFailed
+	default:
+		return caseSkipped
+	}
+}
+
+// junitCaseName prefers "<caseId>: <description>" so cases stay unique within a suite.
+func junitCaseName(tc model.TestCaseResult) string {
+	id := safeString(tc.TestCaseID)
+	switch {
+	case id != "" && tc.TestDescription != "":
+		return fmt.Sprintf("%s: %s", id, tc.TestDescription)
+	case id != "":
+		return id
+	default:
+		return tc.TestDescription
+	}
+}
 
-	return nil
+func formatSeconds(seconds float64) string {
+	return fmt.Sprintf("%.3f", seconds)
 }
@@ -0,0 +1,166 @@
+// automated-test-orchestrator-cli/internal/mappingcheck/check.go
+package mappingcheck
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+)
+
+// Severity indicates whether a finding will break execution or only needs attention.
+type Severity string
+
+const (
+	SeverityError   Severity = "ERROR"
+	SeverityWarning Severity = "WARNING"
+)
+
+// Finding is a single problem detected with a mapping.
+type Finding struct {
+	Severity        Severity `json:"severity"`
+	Check           string   `json:"check"`
+	MappingID       string   `json:"mappingId"`
+	MainComponentID string   `json:"mainComponentId"`
+	TestComponentID string   `json:"testComponentId"`
+	Message         string   `json:"message"`
+	Remediation     string   `json:"remediation"`
+}
+
+// PlatformComponent is what the integration platform reports for a component ID.
+type PlatformComponent struct {
+	Name string
+	Type string
+}
+
+// CheckLocal runs the checks that need nothing but the mappings themselves.
+func CheckLocal(mappings []model.CliMapping) []Finding {
+	var findings []Finding
+
+	byPair := make(map[string][]model.CliMapping)
+	for _, m := range mappings {
+		key := strings.TrimSpace(m.MainComponentID) + "\x00" + strings.TrimSpace(m.TestComponentID)
+		byPair[key] = append(byPair[key], m)
+	}
+
+	for _, m := range mappings {
+		key := strings.TrimSpace(m.MainComponentID) + "\x00" + strings.TrimSpace(m.TestComponentID)
+		if dupes := byPair[key]; len(dupes) > 1 {
+			var others []string
+			for _, d := range dupes {
+				if d.ID != m.ID {
+					others = append(others, d.ID)
+				}
+			}
+			findings = append(findings, newFinding(m, SeverityError, "duplicate-pair",
+				fmt.Sprintf("The same main/test pair is also mapped by %s.", strings.Join(others, ", ")),
+				fmt.Sprintf("Keep one mapping and remove the others with 'ato mappings rm %s'.", others[0])))
+		}
+
+		if strings.TrimSpace(m.MainComponentID) == strings.TrimSpace(m.TestComponentID) {
+			findings = append(findings, newFinding(m, SeverityError, "self-mapping",
+				"The component is mapped as its own test.",
+				fmt.Sprintf("Point the mapping at the test process with 'ato mappings update %s --testId <testComponentId>'.", m.ID)))
+		}
+
+		if isBlank(m.MainComponentName) {
+			findings = append(findings, newFinding(m, SeverityWarning, "missing-main-name",
+				"The main component has no name recorded.",
+				fmt.Sprintf("Run 'ato mappings update %s --main-name <name>'.", m.ID)))
+		}
+		if isBlank(m.TestComponentName) {
+			findings = append(findings, newFinding(m, SeverityWarning, "missing-test-name",
+				"The test component has no name recorded.",
+				fmt.Sprintf("Run 'ato mappings update %s --test-name <name>'.", m.ID)))
+		}
+
+		if m.IsDeployed != nil && !*m.IsDeployed {
+			findings = append(findings, newFinding(m, SeverityWarning, "not-deployed",
+				"The test component is marked as not deployed, so it cannot be executed.",
+				fmt.Sprintf("Deploy the test process, then run 'ato mappings update %s --deployed=true'.", m.ID)))
+		}
+		if m.IsPackaged != nil && !*m.IsPackaged {
+			findings = append(findings, newFinding(m, SeverityWarning, "not-packaged",
+				"The test component is marked as not packaged.",
+				fmt.Sprintf("Package the test process, then run 'ato mappings update %s --packaged=true'.", m.ID)))
+		}
+	}
+
+	return findings
+}
+
+// CheckPlatform compares the mappings against the components the platform
+// resolved. Either map may be nil, in which case those checks are skipped.
+func CheckPlatform(mappings []model.CliMapping, mainComponents, testComponents map[string]PlatformComponent) []Finding {
+	var findings []Finding
+
+	for _, m := range mappings {
+		if mainComponents != nil {
+			if info, ok := mainComponents[m.MainComponentID]; !ok {
+				findings = append(findings, newFinding(m, SeverityError, "main-not-found",
+					"The main component was not found on the integration platform.",
+					fmt.Sprintf("Check whether the component was deleted; if so remove the mapping with 'ato mappings rm %s'.", m.ID)))
+			} else if !isBlank(m.MainComponentName) && info.Name != "" && info.Name != *m.MainComponentName {
+				findings = append(findings, newFinding(m, SeverityWarning, "main-renamed",
+					fmt.Sprintf("The main component is named %q on the platform but %q in the mapping.", info.Name, *m.MainComponentName),
+					fmt.Sprintf("Run 'ato mappings update %s --main-name %q'.", m.ID, info.Name)))
+			}
+		}
+
+		if testComponents != nil {
+			if info, ok := testComponents[m.TestComponentID]; !ok {
+				findings = append(findings, newFinding(m, SeverityError, "test-not-found",
+					"The test component was not found on the integration platform, or is not an executable process.",
+					fmt.Sprintf("Check the test component ID; fix it with 'ato mappings update %s --testId <id>' or remove the mapping.", m.ID)))
+			} else if !isBlank(m.TestComponentName) && info.Name != "" && info.Name != *m.TestComponentName {
+				findings = append(findings, newFinding(m, SeverityWarning, "test-renamed",
+					fmt.Sprintf("The test component is named %q on the platform but %q in the mapping.", info.Name, *m.TestComponentName),
+					fmt.Sprintf("Run 'ato mappings update %s --test-name %q'.", m.ID, info.Name)))
+			}
+		}
+	}
+
+	return findings
+}
+
+// Sort orders findings by severity, then by main and test component.
+func Sort(findings []Finding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.Severity != b.Severity {
+			return a.Severity == SeverityError
+		}
+		if a.MainComponentID != b.MainComponentID {
+			return a.MainComponentID < b.MainComponentID
+		}
+		return a.TestComponentID < b.TestComponentID
+	})
+}
+
+// CountErrors returns the number of findings with error severity.
+func CountErrors(findings []Finding) int {
+	count := 0
+	for _, f := range findings {
+		if f.Severity == SeverityError {
+			count++
+		}
+	}
+	return count
+}
+
+func newFinding(m model.CliMapping, severity Severity, check, message, remediation string) Finding {
+	return Finding{
+		Severity:        severity,
+		Check:           check,
+		MappingID:       m.ID,
+		MainComponentID: m.MainComponentID,
+		TestComponentID: m.TestComponentID,
+		Message:         message,
+		Remediation:     remediation,
+	}
+}
+
+func isBlank(s *string) bool {
+	return s == nil || strings.TrimSpace(*s) == ""
+}
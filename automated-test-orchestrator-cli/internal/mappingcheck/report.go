@@ -0,0 +1,41 @@
+// automated-test-orchestrator-cli/internal/mappingcheck/report.go
+package mappingcheck
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// WriteReport writes findings as CSV or JSON, chosen by the path's extension.
+// JSON is used for stdout and unrecognised extensions.
+func WriteReport(w io.Writer, path string, findings []Finding) error {
+	if strings.ToLower(filepath.Ext(path)) == ".csv" {
+		writer := csv.NewWriter(w)
+		header := []string{"Severity", "Check", "Mapping ID", "Main Component ID", "Test Component ID", "Message", "Remediation"}
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+		for _, f := range findings {
+			row := []string{string(f.Severity), f.Check, f.MappingID, f.MainComponentID, f.TestComponentID, f.Message, f.Remediation}
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	}
+
+	if findings == nil {
+		findings = []Finding{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(findings); err != nil {
+		return fmt.Errorf("failed to encode report: %w", err)
+	}
+	return nil
+}
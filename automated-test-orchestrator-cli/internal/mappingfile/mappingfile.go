@@ -0,0 +1,150 @@
+// automated-test-orchestrator-cli/internal/mappingfile/mappingfile.go
+package mappingfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/csv"
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Entry is a single mapping as written in a YAML or JSON mapping file. An empty
+// component name is treated as unset when reading and writing, as CSV cannot tell
+// the two apart, so every format round-trips the same way.
+type Entry struct {
+	MainComponentID   string  `yaml:"mainComponentId" json:"mainComponentId"`
+	MainComponentName *string `yaml:"mainComponentName,omitempty" json:"mainComponentName,omitempty"`
+	TestComponentID   string  `yaml:"testComponentId" json:"testComponentId"`
+	TestComponentName *string `yaml:"testComponentName,omitempty" json:"testComponentName,omitempty"`
+	IsDeployed        *bool   `yaml:"isDeployed,omitempty" json:"isDeployed,omitempty"`
+	IsPackaged        *bool   `yaml:"isPackaged,omitempty" json:"isPackaged,omitempty"`
+}
+
+// Document is the top-level shape of a YAML or JSON mapping file. A bare list
+// of entries is also accepted when reading.
+type Document struct {
+	Mappings []Entry `yaml:"mappings" json:"mappings"`
+}
+
+// FormatFromPath returns "csv", "yaml" or "json" based on the file extension.
+func FormatFromPath(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return "csv", nil
+	case ".yaml", ".yml":
+		return "yaml", nil
+	case ".json":
+		return "json", nil
+	default:
+		return "", fmt.Errorf("cannot determine mapping file format from '%s'; use a .csv, .yaml or .json file", path)
+	}
+}
+
+// ReadFile loads mappings from a CSV, YAML or JSON file, chosen by extension.
+func ReadFile(path string) ([]model.CreateMappingRequest, error) {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	return Read(file, format)
+}
+
+// Read loads mappings in the given format from a reader. CSV is read in strict
+// mode and any invalid row fails the whole file, because a skipped row would
+// otherwise look like a mapping to delete.
+func Read(r io.Reader, format string) ([]model.CreateMappingRequest, error) {
+	switch format {
+	case "csv":
+		mappings, rowErrors, err := csv.ParseMappingCsv(r, csv.Options{Strict: true})
+		if err != nil {
+			return nil, err
+		}
+		if len(rowErrors) > 0 {
+			messages := make([]string, len(rowErrors))
+			for i, e := range rowErrors {
+				messages[i] = "  " + e.Error()
+			}
+			return nil, fmt.Errorf("mapping file has %d invalid row(s):\n%s", len(rowErrors), strings.Join(messages, "\n"))
+		}
+		return mappings, nil
+	case "yaml", "json":
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		entries, err := decodeEntries(data, format)
+		if err != nil {
+			return nil, err
+		}
+		return toRequests(entries)
+	default:
+		return nil, fmt.Errorf("unsupported mapping file format: %s", format)
+	}
+}
+
+// decodeEntries accepts either a Document or a bare list of entries. YAML is a
+// superset of JSON, but JSON is decoded separately for clearer error messages.
+func decodeEntries(data []byte, format string) ([]Entry, error) {
+	unmarshal := yaml.Unmarshal
+	if format == "json" {
+		unmarshal = json.Unmarshal
+	}
+
+	trimmed := strings.TrimSpace(string(data))
+	if trimmed == "" {
+		return nil, nil
+	}
+
+	if strings.HasPrefix(trimmed, "[") || strings.HasPrefix(trimmed, "-") {
+		var entries []Entry
+		if err := unmarshal(data, &entries); err != nil {
+			return nil, fmt.Errorf("failed to parse %s mapping file: %w", format, err)
+		}
+		return entries, nil
+	}
+
+	var doc Document
+	if err := unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s mapping file: %w", format, err)
+	}
+	return doc.Mappings, nil
+}
+
+func toRequests(entries []Entry) ([]model.CreateMappingRequest, error) {
+	requests := make([]model.CreateMappingRequest, 0, len(entries))
+	for i, e := range entries {
+		if strings.TrimSpace(e.MainComponentID) == "" || strings.TrimSpace(e.TestComponentID) == "" {
+			return nil, fmt.Errorf("mapping %d is missing mainComponentId or testComponentId", i+1)
+		}
+		requests = append(requests, model.CreateMappingRequest{
+			MainComponentID:   strings.TrimSpace(e.MainComponentID),
+			MainComponentName: nonEmpty(e.MainComponentName),
+			TestComponentID:   strings.TrimSpace(e.TestComponentID),
+			TestComponentName: nonEmpty(e.TestComponentName),
+			IsDeployed:        e.IsDeployed,
+			IsPackaged:        e.IsPackaged,
+		})
+	}
+	return requests, nil
+}
+
+// nonEmpty returns nil for an unset or empty name.
+func nonEmpty(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return s
+}
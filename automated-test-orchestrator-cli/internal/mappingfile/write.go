@@ -0,0 +1,108 @@
+// automated-test-orchestrator-cli/internal/mappingfile/write.go
+package mappingfile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+	"gopkg.in/yaml.v3"
+)
+
+// CSVHeader is the column set written by Write and accepted by csv.ParseMappingCsv.
+var CSVHeader = []string{
+	"mainComponentId",
+	"mainComponentName",
+	"testComponentId",
+	"testComponentName",
+	"isDeployed",
+	"isPackaged",
+}
+
+// SupportedFormats lists the formats accepted by Write.
+var SupportedFormats = []string{"csv", "yaml", "json"}
+
+// Write serialises mappings in a format that Read accepts, so an export can be
+// edited and re-imported or applied. Mappings are sorted by main and test
+// component ID to keep diffs stable when the file is kept in version control.
+// Empty component names are written as unset.
+func Write(w io.Writer, mappings []model.CliMapping, format string) error {
+	entries := make([]Entry, 0, len(mappings))
+	for _, m := range mappings {
+		entries = append(entries, Entry{
+			MainComponentID:   m.MainComponentID,
+			MainComponentName: nonEmpty(m.MainComponentName),
+			TestComponentID:   m.TestComponentID,
+			TestComponentName: nonEmpty(m.TestComponentName),
+			IsDeployed:        m.IsDeployed,
+			IsPackaged:        m.IsPackaged,
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].MainComponentID != entries[j].MainComponentID {
+			return entries[i].MainComponentID < entries[j].MainComponentID
+		}
+		return entries[i].TestComponentID < entries[j].TestComponentID
+	})
+
+	switch format {
+	case "csv":
+		return writeCSV(w, entries)
+	case "yaml":
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(Document{Mappings: entries}); err != nil {
+			return err
+		}
+		return encoder.Close()
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(Document{Mappings: entries})
+	default:
+		return fmt.Errorf("unsupported mapping file format: %s", format)
+	}
+}
+
+func writeCSV(w io.Writer, entries []Entry) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(CSVHeader); err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		row := []string{
+			e.MainComponentID,
+			optionalString(e.MainComponentName),
+			e.TestComponentID,
+			optionalString(e.TestComponentName),
+			optionalBool(e.IsDeployed),
+			optionalBool(e.IsPackaged),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func optionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// optionalBool leaves unset values blank, which the CSV parser reads back as unset.
+func optionalBool(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
@@ -0,0 +1,197 @@
+// automated-test-orchestrator-cli/internal/mappingmatrix/matrix.go
+package mappingmatrix
+
+import (
+	"sort"
+	"time"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+)
+
+// Coverage categories for main components.
+const (
+	CoverageNone     = "NONE"
+	CoverageSingle   = "SINGLE"
+	CoverageMultiple = "MULTIPLE"
+)
+
+// MainComponent describes how many tests are mapped to a main component.
+type MainComponent struct {
+	ComponentID   string   `json:"componentId"`
+	ComponentName string   `json:"componentName,omitempty"`
+	Coverage      string   `json:"coverage"` // "NONE", "SINGLE" or "MULTIPLE"
+	TestCount     int      `json:"testCount"`
+	TestIDs       []string `json:"testIds"`
+	InRecentPlans bool     `json:"inRecentPlans"`
+}
+
+// TestComponent describes which main components a test component is mapped to.
+type TestComponent struct {
+	ComponentID      string   `json:"componentId"`
+	ComponentName    string   `json:"componentName,omitempty"`
+	MainCount        int      `json:"mainComponentCount"`
+	MainComponentIDs []string `json:"mainComponentIds"`
+	Shared           bool     `json:"shared"`
+	Orphan           bool     `json:"orphan"`
+}
+
+// Summary holds the headline counts of a matrix.
+type Summary struct {
+	Mappings         int `json:"mappings"`
+	PlansConsidered  int `json:"plansConsidered"`
+	MainComponents   int `json:"mainComponents"`
+	Uncovered        int `json:"uncovered"`
+	SingleTest       int `json:"singleTest"`
+	MultipleTests    int `json:"multipleTests"`
+	TestComponents   int `json:"testComponents"`
+	SharedTests      int `json:"sharedTests"`
+	OrphanTests      int `json:"orphanTests"`
+	SharedThreshold  int `json:"sharedThreshold"`
+	RecentComponents int `json:"recentComponents"`
+}
+
+// Matrix is the coverage picture built from the mappings and recent plans.
+type Matrix struct {
+	GeneratedAt    time.Time       `json:"generatedAt"`
+	Since          *time.Time      `json:"since,omitempty"`
+	Summary        Summary         `json:"summary"`
+	MainComponents []MainComponent `json:"mainComponents"`
+	TestComponents []TestComponent `json:"testComponents"`
+}
+
+// Build computes the matrix. Main components that appear in the plans but have
+// no mappings are reported with NONE coverage. A test is shared when it is mapped
+// to at least sharedThreshold main components, and an orphan when none of its
+// main components appear in any of the plans. When plans is empty, no test is
+// considered an orphan because there is nothing to compare against.
+func Build(mappings []model.CliMapping, plans []*model.CliTestPlan, sharedThreshold int) Matrix {
+	if sharedThreshold < 2 {
+		sharedThreshold = 2
+	}
+
+	recent := make(map[string]string) // component ID -> name seen in a plan
+	for _, plan := range plans {
+		for _, c := range plan.PlanComponents {
+			name := recent[c.ComponentID]
+			if c.ComponentName != nil && *c.ComponentName != "" {
+				name = *c.ComponentName
+			}
+			recent[c.ComponentID] = name
+		}
+	}
+
+	mains := make(map[string]*MainComponent)
+	tests := make(map[string]*TestComponent)
+	for _, m := range mappings {
+		main, ok := mains[m.MainComponentID]
+		if !ok {
+			main = &MainComponent{ComponentID: m.MainComponentID, TestIDs: []string{}}
+			mains[m.MainComponentID] = main
+		}
+		if main.ComponentName == "" && m.MainComponentName != nil {
+			main.ComponentName = *m.MainComponentName
+		}
+		main.TestIDs = appendUnique(main.TestIDs, m.TestComponentID)
+
+		test, ok := tests[m.TestComponentID]
+		if !ok {
+			test = &TestComponent{ComponentID: m.TestComponentID, MainComponentIDs: []string{}}
+			tests[m.TestComponentID] = test
+		}
+		if test.ComponentName == "" && m.TestComponentName != nil {
+			test.ComponentName = *m.TestComponentName
+		}
+		test.MainComponentIDs = appendUnique(test.MainComponentIDs, m.MainComponentID)
+	}
+
+	for id, name := range recent {
+		if _, ok := mains[id]; ok {
+			continue
+		}
+		// Test components are plan components too, but they are not expected to have tests.
+		if _, ok := tests[id]; ok {
+			continue
+		}
+		mains[id] = &MainComponent{ComponentID: id, ComponentName: name, TestIDs: []string{}}
+	}
+
+	matrix := Matrix{
+		GeneratedAt:    time.Now(),
+		MainComponents: []MainComponent{},
+		TestComponents: []TestComponent{},
+	}
+	matrix.Summary.Mappings = len(mappings)
+	matrix.Summary.PlansConsidered = len(plans)
+	matrix.Summary.SharedThreshold = sharedThreshold
+	matrix.Summary.RecentComponents = len(recent)
+
+	for _, main := range mains {
+		sort.Strings(main.TestIDs)
+		main.TestCount = len(main.TestIDs)
+		_, main.InRecentPlans = recent[main.ComponentID]
+		switch main.TestCount {
+		case 0:
+			main.Coverage = CoverageNone
+			matrix.Summary.Uncovered++
+		case 1:
+			main.Coverage = CoverageSingle
+			matrix.Summary.SingleTest++
+		default:
+			main.Coverage = CoverageMultiple
+			matrix.Summary.MultipleTests++
+		}
+		matrix.MainComponents = append(matrix.MainComponents, *main)
+	}
+
+	for _, test := range tests {
+		sort.Strings(test.MainComponentIDs)
+		test.MainCount = len(test.MainComponentIDs)
+		test.Shared = test.MainCount >= sharedThreshold
+		if len(plans) > 0 {
+			test.Orphan = true
+			for _, id := range test.MainComponentIDs {
+				if _, ok := recent[id]; ok {
+					test.Orphan = false
+					break
+				}
+			}
+		}
+		if test.Shared {
+			matrix.Summary.SharedTests++
+		}
+		if test.Orphan {
+			matrix.Summary.OrphanTests++
+		}
+		matrix.TestComponents = append(matrix.TestComponents, *test)
+	}
+
+	matrix.Summary.MainComponents = len(matrix.MainComponents)
+	matrix.Summary.TestComponents = len(matrix.TestComponents)
+
+	// Least-covered components and most-shared tests first, as these are what a review looks at.
+	sort.Slice(matrix.MainComponents, func(i, j int) bool {
+		a, b := matrix.MainComponents[i], matrix.MainComponents[j]
+		if a.TestCount != b.TestCount {
+			return a.TestCount < b.TestCount
+		}
+		return a.ComponentID < b.ComponentID
+	})
+	sort.Slice(matrix.TestComponents, func(i, j int) bool {
+		a, b := matrix.TestComponents[i], matrix.TestComponents[j]
+		if a.MainCount != b.MainCount {
+			return a.MainCount > b.MainCount
+		}
+		return a.ComponentID < b.ComponentID
+	})
+
+	return matrix
+}
+
+func appendUnique(ids []string, id string) []string {
+	for _, existing := range ids {
+		if existing == id {
+			return ids
+		}
+	}
+	return append(ids, id)
+}
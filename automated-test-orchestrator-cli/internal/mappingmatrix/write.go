@@ -0,0 +1,83 @@
+// automated-test-orchestrator-cli/internal/mappingmatrix/write.go
+package mappingmatrix
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// SupportedFormats lists the file formats accepted by Write.
+var SupportedFormats = []string{"csv", "json"}
+
+// Write serialises the matrix. CSV has one row per component, with a Role column
+// of "main" or "test"; JSON keeps the full structure including the summary.
+func Write(w io.Writer, matrix Matrix, format string) error {
+	switch strings.ToLower(format) {
+	case "csv":
+		return writeCSV(w, matrix)
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(matrix)
+	default:
+		return fmt.Errorf("unsupported matrix format: %s", format)
+	}
+}
+
+func writeCSV(w io.Writer, matrix Matrix) error {
+	writer := csv.NewWriter(w)
+	header := []string{
+		"Role",
+		"Component ID",
+		"Component Name",
+		"Linked Count",
+		"Linked IDs",
+		"Coverage",
+		"In Recent Plans",
+		"Shared",
+		"Orphan",
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, m := range matrix.MainComponents {
+		row := []string{
+			"main",
+			m.ComponentID,
+			m.ComponentName,
+			strconv.Itoa(m.TestCount),
+			strings.Join(m.TestIDs, ";"),
+			m.Coverage,
+			strconv.FormatBool(m.InRecentPlans),
+			"",
+			"",
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	for _, t := range matrix.TestComponents {
+		row := []string{
+			"test",
+			t.ComponentID,
+			t.ComponentName,
+			strconv.Itoa(t.MainCount),
+			strings.Join(t.MainComponentIDs, ";"),
+			"",
+			"",
+			strconv.FormatBool(t.Shared),
+			strconv.FormatBool(t.Orphan),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,159 @@
+// automated-test-orchestrator-cli/internal/mappingsync/plan.go
+package mappingsync
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+)
+
+// Key identifies a mapping by its (main component, test component) pair, which
+// is unique on the server.
+type Key struct {
+	MainComponentID string
+	TestComponentID string
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("%s -> %s", k.MainComponentID, k.TestComponentID)
+}
+
+// KeyOf returns the key of an existing mapping.
+func KeyOf(m model.CliMapping) Key {
+	return Key{MainComponentID: strings.TrimSpace(m.MainComponentID), TestComponentID: strings.TrimSpace(m.TestComponentID)}
+}
+
+// KeyOfRequest returns the key of a desired mapping.
+func KeyOfRequest(m model.CreateMappingRequest) Key {
+	return Key{MainComponentID: strings.TrimSpace(m.MainComponentID), TestComponentID: strings.TrimSpace(m.TestComponentID)}
+}
+
+// FieldChange describes a single attribute that differs between the server and the file.
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// Update is an existing mapping whose attributes need to change.
+type Update struct {
+	Existing model.CliMapping
+	Request  model.UpdateMappingRequest
+	Changes  []FieldChange
+}
+
+// Plan is the set of operations needed to converge the server on a mapping file.
+type Plan struct {
+	Creates   []model.CreateMappingRequest
+	Updates   []Update
+	Deletes   []model.CliMapping
+	Unchanged int
+}
+
+// HasChanges reports whether applying the plan would modify anything.
+func (p Plan) HasChanges() bool {
+	return len(p.Creates) > 0 || len(p.Updates) > 0 || len(p.Deletes) > 0
+}
+
+// Compute diffs the desired mappings against the existing ones. Attributes that
+// are absent from the file are left untouched. Existing mappings missing from the
+// file are only deleted when prune is true.
+func Compute(desired []model.CreateMappingRequest, existing []model.CliMapping, prune bool) (Plan, error) {
+	var plan Plan
+
+	existingByKey := make(map[Key]model.CliMapping, len(existing))
+	for _, m := range existing {
+		existingByKey[KeyOf(m)] = m
+	}
+
+	seen := make(map[Key]bool, len(desired))
+	for _, d := range desired {
+		key := KeyOfRequest(d)
+		if seen[key] {
+			return Plan{}, fmt.Errorf("mapping %s appears more than once in the file", key)
+		}
+		seen[key] = true
+
+		current, ok := existingByKey[key]
+		if !ok {
+			d.MainComponentID = key.MainComponentID
+			d.TestComponentID = key.TestComponentID
+			plan.Creates = append(plan.Creates, d)
+			continue
+		}
+
+		if update, changed := diff(current, d); changed {
+			plan.Updates = append(plan.Updates, update)
+		} else {
+			plan.Unchanged++
+		}
+	}
+
+	if prune {
+		for _, m := range existing {
+			if !seen[KeyOf(m)] {
+				plan.Deletes = append(plan.Deletes, m)
+			}
+		}
+	}
+
+	sort.SliceStable(plan.Creates, func(i, j int) bool {
+		return KeyOfRequest(plan.Creates[i]).String() < KeyOfRequest(plan.Creates[j]).String()
+	})
+	sort.SliceStable(plan.Updates, func(i, j int) bool {
+		return KeyOf(plan.Updates[i].Existing).String() < KeyOf(plan.Updates[j].Existing).String()
+	})
+	sort.SliceStable(plan.Deletes, func(i, j int) bool {
+		return KeyOf(plan.Deletes[i]).String() < KeyOf(plan.Deletes[j]).String()
+	})
+
+	return plan, nil
+}
+
+func diff(current model.CliMapping, desired model.CreateMappingRequest) (Update, bool) {
+	update := Update{Existing: current}
+
+	if desired.MainComponentName != nil && !equalString(current.MainComponentName, desired.MainComponentName) {
+		update.Request.MainComponentName = desired.MainComponentName
+		update.Changes = append(update.Changes, FieldChange{"mainComponentName", formatString(current.MainComponentName), formatString(desired.MainComponentName)})
+	}
+	if desired.TestComponentName != nil && !equalString(current.TestComponentName, desired.TestComponentName) {
+		update.Request.TestComponentName = desired.TestComponentName
+		update.Changes = append(update.Changes, FieldChange{"testComponentName", formatString(current.TestComponentName), formatString(desired.TestComponentName)})
+	}
+	if desired.IsDeployed != nil && !equalBool(current.IsDeployed, desired.IsDeployed) {
+		update.Request.IsDeployed = desired.IsDeployed
+		update.Changes = append(update.Changes, FieldChange{"isDeployed", formatBool(current.IsDeployed), formatBool(desired.IsDeployed)})
+	}
+	if desired.IsPackaged != nil && !equalBool(current.IsPackaged, desired.IsPackaged) {
+		update.Request.IsPackaged = desired.IsPackaged
+		update.Changes = append(update.Changes, FieldChange{"isPackaged", formatBool(current.IsPackaged), formatBool(desired.IsPackaged)})
+	}
+
+	return update, len(update.Changes) > 0
+}
+
+func equalString(a, b *string) bool {
+	return formatString(a) == formatString(b)
+}
+
+func equalBool(a, b *bool) bool {
+	return formatBool(a) == formatBool(b)
+}
+
+func formatString(s *string) string {
+	if s == nil {
+		return "(null)"
+	}
+	return strconv.Quote(*s)
+}
+
+func formatBool(b *bool) string {
+	if b == nil {
+		return "(null)"
+	}
+	return strconv.FormatBool(*b)
+}
@@ -22,3 +22,12 @@ type AddCredentialRequest struct {
 	PasswordOrToken     string `json:"passwordOrToken"`
 	ExecutionInstanceID string `json:"executionInstanceId"`
 }
+
+// Structure for the PATCH /credentials/{profileName} request body. Only non-nil
+// fields are sent, so unspecified fields keep their stored values.
+type UpdateCredentialRequest struct {
+	AccountID           *string `json:"accountId,omitempty"`
+	Username            *string `json:"username,omitempty"`
+	PasswordOrToken     *string `json:"passwordOrToken,omitempty"`
+	ExecutionInstanceID *string `json:"executionInstanceId,omitempty"`
+}
@@ -25,3 +25,13 @@ type CreateMappingRequest struct {
 	IsDeployed        *bool   `json:"isDeployed,omitempty"`
 	IsPackaged        *bool   `json:"isPackaged,omitempty"`
 }
+
+// UpdateMappingRequest is the structure for the PUT /mappings/{mappingId} request body.
+// Only non-nil fields are sent, so unspecified fields keep their current values.
+type UpdateMappingRequest struct {
+	MainComponentName *string `json:"mainComponentName,omitempty"`
+	TestComponentID   *string `json:"testComponentId,omitempty"`
+	TestComponentName *string `json:"testComponentName,omitempty"`
+	IsDeployed        *bool   `json:"isDeployed,omitempty"`
+	IsPackaged        *bool   `json:"isPackaged,omitempty"`
+}
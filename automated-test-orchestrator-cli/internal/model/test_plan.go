@@ -11,6 +11,7 @@ type CliTestExecutionResult struct {
 	Status            string           `json:"status"` // "SUCCESS" or "FAILURE"
 	Message           *string          `json:"message,omitempty"`
 	TestCases         []TestCaseResult `json:"testCases,omitempty"`
+	ExecutedAt        time.Time        `json:"executedAt"`
 }
 
 // CliAvailableTest holds the ID and Name of a test found during discovery.
@@ -23,6 +24,7 @@ type CliAvailableTest struct {
 type CliPlanComponent struct {
 	ID               string                   `json:"id"`
 	TestPlanID       string                   `json:"testPlanId"`
+	SourceType       string                   `json:"sourceType,omitempty"` // "ARG" for TEST plan inputs, "DISCOVERED" otherwise
 	ComponentID      string                   `json:"componentId"`
 	ComponentName    *string                  `json:"componentName,omitempty"`
 	ComponentType    *string                  `json:"componentType,omitempty"`
@@ -30,15 +32,60 @@ type CliPlanComponent struct {
 	ExecutionResults []CliTestExecutionResult `json:"executionResults"`
 }
 
+// CliTestPlanEntryPoint is a component that was supplied as an input to a plan.
+type CliTestPlanEntryPoint struct {
+	ID          string `json:"id"`
+	ComponentID string `json:"componentId"`
+}
+
 // CliTestPlan represents the entire Test Plan object returned by the API.
 type CliTestPlan struct {
-	ID             string             `json:"id"`
-	Name           string             `json:"name"`
-	Status         string             `json:"status"`
-	FailureReason  *string            `json:"failureReason,omitempty"`
-	CreatedAt      time.Time          `json:"createdAt"`
-	UpdatedAt      time.Time          `json:"updatedAt"`
-	PlanComponents []CliPlanComponent `json:"planComponents"`
+	ID             string                  `json:"id"`
+	Name           string                  `json:"name"`
+	PlanType       string                  `json:"planType,omitempty"`
+	Status         string                  `json:"status"`
+	FailureReason  *string                 `json:"failureReason,omitempty"`
+	CreatedAt      time.Time               `json:"createdAt"`
+	UpdatedAt      time.Time               `json:"updatedAt"`
+	EntryPoints    []CliTestPlanEntryPoint `json:"entryPoints,omitempty"`
+	PlanComponents []CliPlanComponent      `json:"planComponents"`
+}
+
+// IsEntryPoint reports whether the component was supplied as an input to the plan
+// rather than found through dependency discovery. COMPONENT plans save their inputs
+// as DISCOVERED components, so only the plan's entry points tell them apart;
+// SourceType is a fallback for servers that don't return entry points.
+func (p *CliTestPlan) IsEntryPoint(c CliPlanComponent) bool {
+	for _, e := range p.EntryPoints {
+		if e.ComponentID == c.ComponentID {
+			return true
+		}
+	}
+	return c.SourceType == "ARG"
+}
+
+// EnrichedResults flattens the plan's per-component results into the same shape
+// returned by the results endpoint, so they can be rendered and exported alike.
+func (p *CliTestPlan) EnrichedResults() []CliEnrichedTestExecutionResult {
+	var results []CliEnrichedTestExecutionResult
+	for _, comp := range p.PlanComponents {
+		for _, res := range comp.ExecutionResults {
+			results = append(results, CliEnrichedTestExecutionResult{
+				ID:                res.ID,
+				TestPlanID:        p.ID,
+				TestPlanName:      &p.Name,
+				PlanComponentID:   comp.ComponentID,
+				ComponentName:     comp.ComponentName,
+				TestComponentID:   res.TestComponentID,
+				TestComponentName: res.TestComponentName,
+				Status:            res.Status,
+				Message:           res.Message,
+				TestCases:         res.TestCases,
+				ExecutedAt:        res.ExecutedAt,
+			})
+		}
+	}
+	return results
 }
 
 // CliTestPlanSummary represents a summary of a Test Plan for the list view.
@@ -0,0 +1,8 @@
+// automated-test-orchestrator-cli/internal/model/version.go
+package model
+
+// CliServerVersion is the server and API contract version reported by the backend.
+type CliServerVersion struct {
+	Version    string `json:"version"`
+	APIVersion string `json:"apiVersion"`
+}
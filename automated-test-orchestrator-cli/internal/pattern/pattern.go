@@ -0,0 +1,43 @@
+// automated-test-orchestrator-cli/internal/pattern/pattern.go
+package pattern
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Compile turns a user-supplied name pattern into a case-insensitive regular
+// expression. A pattern wrapped in slashes, such as /^nightly-\d+$/, is used as a
+// regular expression. A pattern containing * or ? is a glob matched against the
+// whole name. Anything else matches as a substring.
+func Compile(pattern string) (*regexp.Regexp, error) {
+	var expr string
+	switch {
+	case len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/"):
+		expr = pattern[1 : len(pattern)-1]
+	case strings.ContainsAny(pattern, "*?"):
+		var b strings.Builder
+		b.WriteString("^")
+		for _, c := range pattern {
+			switch c {
+			case '*':
+				b.WriteString(".*")
+			case '?':
+				b.WriteString(".")
+			default:
+				b.WriteString(regexp.QuoteMeta(string(c)))
+			}
+		}
+		b.WriteString("$")
+		expr = b.String()
+	default:
+		expr = regexp.QuoteMeta(pattern)
+	}
+
+	re, err := regexp.Compile("(?i)" + expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	return re, nil
+}
@@ -0,0 +1,158 @@
+// automated-test-orchestrator-cli/internal/planquery/query.go
+package planquery
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+	"github.com/automated-test-orchestrator/cli-go/internal/pattern"
+)
+
+// Sort keys accepted by Query.Sort.
+const (
+	SortCreated = "created"
+	SortUpdated = "updated"
+	SortName    = "name"
+)
+
+// SortKeys lists the supported sort keys.
+var SortKeys = []string{SortCreated, SortUpdated, SortName}
+
+// Query selects and orders test plans. The zero value matches every plan and
+// sorts newest first.
+type Query struct {
+	Statuses []string
+	Name     *regexp.Regexp
+	Since    time.Time // Only plans created at or after this time
+	Until    time.Time // Only plans created before this time
+	Sort     string    // One of SortKeys; defaults to SortCreated
+	Reverse  bool
+	Limit    int // Zero for no limit
+
+	namePattern string
+}
+
+// SetName compiles a name pattern; see pattern.Compile for the syntax.
+func (q *Query) SetName(namePattern string) error {
+	q.namePattern = namePattern
+	if namePattern == "" {
+		q.Name = nil
+		return nil
+	}
+
+	re, err := pattern.Compile(namePattern)
+	if err != nil {
+		return err
+	}
+	q.Name = re
+	return nil
+}
+
+// SetSort validates and sets the sort key.
+func (q *Query) SetSort(key string) error {
+	key = strings.ToLower(key)
+	for _, k := range SortKeys {
+		if k == key {
+			q.Sort = key
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported sort key %q; use one of %s", key, strings.Join(SortKeys, ", "))
+}
+
+// Values encodes the query as URL parameters so the API can filter server-side
+// once it supports them. The API currently ignores them, so Apply must still be
+// used on the response.
+func (q Query) Values() url.Values {
+	v := url.Values{}
+	if len(q.Statuses) > 0 {
+		v.Set("status", strings.Join(q.Statuses, ","))
+	}
+	if q.namePattern != "" {
+		v.Set("name", q.namePattern)
+	}
+	if !q.Since.IsZero() {
+		v.Set("since", q.Since.UTC().Format(time.RFC3339))
+	}
+	if !q.Until.IsZero() {
+		v.Set("until", q.Until.UTC().Format(time.RFC3339))
+	}
+	if q.Sort != "" {
+		v.Set("sort", q.Sort)
+	}
+	if q.Reverse {
+		v.Set("order", "reverse")
+	}
+	if q.Limit > 0 {
+		v.Set("limit", strconv.Itoa(q.Limit))
+	}
+	return v
+}
+
+// Matches reports whether a plan passes the query's filters.
+func (q Query) Matches(p model.CliTestPlanSummary) bool {
+	if len(q.Statuses) > 0 {
+		found := false
+		for _, st := range q.Statuses {
+			if strings.EqualFold(strings.TrimSpace(st), p.Status) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if q.Name != nil && !q.Name.MatchString(p.Name) {
+		return false
+	}
+	if !q.Since.IsZero() && p.CreatedAt.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && !p.CreatedAt.Before(q.Until) {
+		return false
+	}
+	return true
+}
+
+// Apply filters, sorts and limits plans. Dates sort newest first and names sort
+// alphabetically; Reverse flips either order.
+func (q Query) Apply(plans []model.CliTestPlanSummary) []model.CliTestPlanSummary {
+	result := []model.CliTestPlanSummary{}
+	for _, p := range plans {
+		if q.Matches(p) {
+			result = append(result, p)
+		}
+	}
+
+	less := func(a, b model.CliTestPlanSummary) bool {
+		switch q.Sort {
+		case SortName:
+			if !strings.EqualFold(a.Name, b.Name) {
+				return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+			}
+			return a.CreatedAt.After(b.CreatedAt)
+		case SortUpdated:
+			return a.UpdatedAt.After(b.UpdatedAt)
+		default:
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if q.Reverse {
+			return less(result[j], result[i])
+		}
+		return less(result[i], result[j])
+	})
+
+	if q.Limit > 0 && len(result) > q.Limit {
+		result = result[:q.Limit]
+	}
+	return result
+}
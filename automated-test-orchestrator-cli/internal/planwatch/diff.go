@@ -0,0 +1,58 @@
+// automated-test-orchestrator-cli/internal/planwatch/diff.go
+package planwatch
+
+import (
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+)
+
+// Event kinds reported by Diff.
+const (
+	StatusChanged = "STATUS_CHANGED"
+	ResultAdded   = "RESULT_ADDED"
+)
+
+// Event is a change observed between two polls of the same plan.
+type Event struct {
+	Kind   string
+	From   string                               // Previous status, for StatusChanged
+	To     string                               // New status, for StatusChanged
+	Result model.CliEnrichedTestExecutionResult // The new result, for ResultAdded
+}
+
+// IsTerminal reports whether a plan in this status will not change any further.
+func IsTerminal(status string) bool {
+	switch status {
+	case "COMPLETED", "DISCOVERY_FAILED", "EXECUTION_FAILED":
+		return true
+	default:
+		return false
+	}
+}
+
+// IsFailed reports whether the status is one of the failure states.
+func IsFailed(status string) bool {
+	return status == "DISCOVERY_FAILED" || status == "EXECUTION_FAILED"
+}
+
+// Diff returns what changed from prev to curr: a status transition, if any,
+// followed by every result that was not present before, in plan order. A nil
+// prev reports every current result as new and no transition.
+func Diff(prev, curr *model.CliTestPlan) []Event {
+	var events []Event
+	seen := make(map[string]bool)
+	if prev != nil {
+		if prev.Status != curr.Status {
+			events = append(events, Event{Kind: StatusChanged, From: prev.Status, To: curr.Status})
+		}
+		for _, r := range prev.EnrichedResults() {
+			seen[r.ID] = true
+		}
+	}
+
+	for _, r := range curr.EnrichedResults() {
+		if !seen[r.ID] {
+			events = append(events, Event{Kind: ResultAdded, Result: r})
+		}
+	}
+	return events
+}
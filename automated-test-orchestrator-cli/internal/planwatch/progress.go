@@ -0,0 +1,69 @@
+// automated-test-orchestrator-cli/internal/planwatch/progress.go
+package planwatch
+
+import (
+	"fmt"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+)
+
+// Progress counts how far an execution has got.
+type Progress struct {
+	Finished int
+	Failed   int
+	Total    int // Zero when the number of tests to run is not known
+}
+
+// ProgressOf counts the results a plan has so far against the tests it is
+// expected to run. selected is the list of test IDs passed to the execution, or
+// empty when every available test runs.
+func ProgressOf(plan *model.CliTestPlan, selected []string) Progress {
+	var p Progress
+	for _, r := range plan.EnrichedResults() {
+		p.Finished++
+		if r.Status != "SUCCESS" {
+			p.Failed++
+		}
+	}
+
+	// Mirrors how the API picks the tests to run: in TEST plans the components are
+	// the tests. Otherwise every mapped test runs once per component it is mapped
+	// to, while a selection runs each selected test once.
+	runnable := make(map[string]bool)
+	available := 0
+	for _, comp := range plan.PlanComponents {
+		if plan.PlanType == "TEST" {
+			runnable[comp.ComponentID] = true
+			available++
+			continue
+		}
+		for _, t := range comp.AvailableTests {
+			runnable[t.ID] = true
+			available++
+		}
+	}
+	if len(selected) == 0 {
+		p.Total = available
+	} else {
+		for _, id := range selected {
+			if runnable[id] {
+				p.Total++
+			}
+		}
+	}
+
+	// Never report more finished than expected, e.g. when attaching to a plan that
+	// was run with a selection we don't know about.
+	if p.Total < p.Finished {
+		p.Total = p.Finished
+	}
+	return p
+}
+
+// String renders the progress as "12/40 tests finished, 3 failed".
+func (p Progress) String() string {
+	if p.Total == 0 {
+		return fmt.Sprintf("%d tests finished, %d failed", p.Finished, p.Failed)
+	}
+	return fmt.Sprintf("%d/%d tests finished, %d failed", p.Finished, p.Total, p.Failed)
+}
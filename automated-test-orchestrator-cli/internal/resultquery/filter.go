@@ -0,0 +1,104 @@
+// automated-test-orchestrator-cli/internal/resultquery/filter.go
+package resultquery
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+)
+
+// Filter narrows execution results on fields the results endpoint cannot filter
+// by. Zero-valued fields match everything.
+type Filter struct {
+	Since      time.Time      // Only results executed at or after this time
+	Until      time.Time      // Only results executed before this time
+	PlanName   *regexp.Regexp // Matched against the test plan name
+	TestName   *regexp.Regexp // Matched against the test component name
+	CaseStatus string         // Keep only test cases with this status, e.g. "FAILED"
+	Grep       *regexp.Regexp // Matched against the result message and test case details
+}
+
+// Apply returns the results that pass the filter. With CaseStatus or Grep, the
+// test cases of each result are narrowed to the ones that match, and results left
+// without a match are dropped. A result that produced no test cases at all is
+// matched on its own status instead, so process-level failures still show up for
+// FAILED. The input slice is not modified.
+func (f Filter) Apply(results []model.CliEnrichedTestExecutionResult) []model.CliEnrichedTestExecutionResult {
+	filtered := []model.CliEnrichedTestExecutionResult{}
+	for _, r := range results {
+		if !f.Since.IsZero() && r.ExecutedAt.Before(f.Since) {
+			continue
+		}
+		if !f.Until.IsZero() && !r.ExecutedAt.Before(f.Until) {
+			continue
+		}
+		if f.PlanName != nil && !f.PlanName.MatchString(nameOrID(r.TestPlanName, r.TestPlanID)) {
+			continue
+		}
+		if f.TestName != nil && !f.TestName.MatchString(nameOrID(r.TestComponentName, r.TestComponentID)) {
+			continue
+		}
+
+		if f.CaseStatus != "" {
+			if len(r.TestCases) == 0 {
+				if !strings.EqualFold(caseStatusOf(r.Status), f.CaseStatus) {
+					continue
+				}
+			} else {
+				r.TestCases = filterCases(r.TestCases, func(tc model.TestCaseResult) bool {
+					return strings.EqualFold(tc.Status, f.CaseStatus)
+				})
+				if len(r.TestCases) == 0 {
+					continue
+				}
+			}
+		}
+
+		// A matching message keeps the whole result; otherwise only the cases
+		// whose details or description match are kept.
+		if f.Grep != nil && !(r.Message != nil && f.Grep.MatchString(*r.Message)) {
+			r.TestCases = filterCases(r.TestCases, func(tc model.TestCaseResult) bool {
+				return f.Grep.MatchString(tc.TestDescription) || (tc.Details != nil && f.Grep.MatchString(*tc.Details))
+			})
+			if len(r.TestCases) == 0 {
+				continue
+			}
+		}
+
+		filtered = append(filtered, r)
+	}
+	return filtered
+}
+
+// caseStatusOf maps a result status to the test case status it corresponds to.
+func caseStatusOf(resultStatus string) string {
+	switch resultStatus {
+	case "SUCCESS":
+		return "PASSED"
+	case "FAILURE":
+		return "FAILED"
+	default:
+		return resultStatus
+	}
+}
+
+func filterCases(cases []model.TestCaseResult, keep func(model.TestCaseResult) bool) []model.TestCaseResult {
+	var kept []model.TestCaseResult
+	for _, tc := range cases {
+		if keep(tc) {
+			kept = append(kept, tc)
+		}
+	}
+	return kept
+}
+
+// nameOrID matches names where available and falls back to the ID, so results
+// recorded without a name can still be selected.
+func nameOrID(name *string, id string) string {
+	if name != nil && *name != "" {
+		return *name
+	}
+	return id
+}
@@ -0,0 +1,106 @@
+package style
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// ProgressBar renders a single-line progress bar with an ETA on stderr. When
+// stderr is not a terminal it falls back to a plain line every 10%.
+type ProgressBar struct {
+	mu          sync.Mutex
+	w           io.Writer
+	label       string
+	total       int
+	done        int
+	start       time.Time
+	interactive bool
+	lastDecile  int
+}
+
+const progressBarWidth = 30
+
+// NewProgressBar creates and draws a progress bar for total units of work.
+func NewProgressBar(total int, label string) *ProgressBar {
+	p := &ProgressBar{
+		w:           os.Stderr,
+		label:       label,
+		total:       total,
+		start:       time.Now(),
+		interactive: term.IsTerminal(int(os.Stderr.Fd())),
+	}
+	p.mu.Lock()
+	p.draw()
+	p.mu.Unlock()
+	return p
+}
+
+// Increment records one completed unit of work and redraws the bar.
+func (p *ProgressBar) Increment() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.done++
+	p.draw()
+}
+
+// Printf prints a message above the bar without corrupting it.
+func (p *ProgressBar) Printf(format string, a ...interface{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.clear()
+	fmt.Fprintf(p.w, format, a...)
+	if !strings.HasSuffix(format, "\n") {
+		fmt.Fprintln(p.w)
+	}
+	p.draw()
+}
+
+// Finish clears the bar so that subsequent output starts on a clean line.
+func (p *ProgressBar) Finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.clear()
+}
+
+func (p *ProgressBar) clear() {
+	if p.interactive {
+		fmt.Fprint(p.w, "\r\033[K")
+	}
+}
+
+func (p *ProgressBar) draw() {
+	fraction := 1.0
+	if p.total > 0 {
+		fraction = float64(p.done) / float64(p.total)
+	}
+
+	if !p.interactive {
+		decile := int(fraction * 10)
+		if decile == p.lastDecile && p.done != 0 {
+			return
+		}
+		p.lastDecile = decile
+		fmt.Fprintf(p.w, "%s %d/%d (%d%%) %s\n", p.label, p.done, p.total, int(fraction*100), p.eta())
+		return
+	}
+
+	filled := int(fraction * progressBarWidth)
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", progressBarWidth-filled)
+	fmt.Fprintf(p.w, "\r\033[K%s %s %d/%d (%d%%) %s", p.label, Cyan(bar), p.done, p.total, int(fraction*100), Faint(p.eta()))
+}
+
+// eta estimates the remaining time from the average rate so far.
+func (p *ProgressBar) eta() string {
+	if p.done == 0 || p.done >= p.total {
+		return "elapsed " + time.Since(p.start).Round(time.Second).String()
+	}
+	perItem := time.Since(p.start) / time.Duration(p.done)
+	remaining := perItem * time.Duration(p.total-p.done)
+	return "ETA " + remaining.Round(time.Second).String()
+}
@@ -0,0 +1,108 @@
+// automated-test-orchestrator-cli/internal/tui/commands.go
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/client"
+	"github.com/automated-test-orchestrator/cli-go/internal/errors"
+	"github.com/automated-test-orchestrator/cli-go/internal/export"
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Messages delivered to Update when background work finishes.
+type (
+	tickMsg  time.Time
+	plansMsg struct {
+		plans []model.CliTestPlanSummary
+		err   error
+	}
+	planMsg struct {
+		id   string
+		plan *model.CliTestPlan
+		err  error
+	}
+	// actionMsg reports the outcome of an execute, export or delete.
+	actionMsg struct {
+		text    string
+		err     error
+		deleted string // ID of the plan that was deleted, if any
+	}
+)
+
+func tick(interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg { return tickMsg(t) })
+}
+
+func loadPlans(c *client.APIClient) tea.Cmd {
+	return func() tea.Msg {
+		plans, err := c.GetAllPlans()
+		return plansMsg{plans: plans, err: err}
+	}
+}
+
+func loadPlan(c *client.APIClient, planID string) tea.Cmd {
+	return func() tea.Msg {
+		plan, err := c.GetPlanStatus(planID)
+		return planMsg{id: planID, plan: plan, err: err}
+	}
+}
+
+// executePlan starts an execution; testIDs nil runs every available test.
+func executePlan(c *client.APIClient, planID string, testIDs []string, profile, what string) tea.Cmd {
+	return func() tea.Msg {
+		if err := c.InitiateExecution(planID, testIDs, profile); err != nil {
+			return actionMsg{err: fmt.Errorf("failed to start execution: %s", errors.FormatError(err))}
+		}
+		return actionMsg{text: fmt.Sprintf("Started execution of %s.", what)}
+	}
+}
+
+func deletePlan(c *client.APIClient, planID string) tea.Cmd {
+	return func() tea.Msg {
+		if err := c.DeleteTestPlan(planID); err != nil {
+			return actionMsg{err: fmt.Errorf("failed to delete plan: %s", errors.FormatError(err))}
+		}
+		return actionMsg{text: fmt.Sprintf("Deleted plan %s.", planID), deleted: planID}
+	}
+}
+
+// exportPlan writes the plan's results to <dir>/<planId>.<ext>.
+func exportPlan(plan *model.CliTestPlan, dir, format, profile string) tea.Cmd {
+	return func() tea.Msg {
+		exporter, err := export.NewExporter(format)
+		if err != nil {
+			return actionMsg{err: err}
+		}
+		if xmlExporter, ok := exporter.(*export.XMLExporter); ok {
+			xmlExporter.CredentialProfile = profile
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return actionMsg{err: err}
+		}
+
+		path := filepath.Join(dir, plan.ID+"."+fileExtension(format))
+		if err := export.ExportToPath(exporter, plan.EnrichedResults(), path); err != nil {
+			return actionMsg{err: fmt.Errorf("failed to export results: %w", err)}
+		}
+		absPath, _ := filepath.Abs(path)
+		return actionMsg{text: fmt.Sprintf("Exported %s results to %s.", format, absPath)}
+	}
+}
+
+// fileExtension is the inverse of export.FormatFromPath.
+func fileExtension(format string) string {
+	switch strings.ToLower(format) {
+	case "junit":
+		return "xml"
+	case "markdown":
+		return "md"
+	default:
+		return strings.ToLower(format)
+	}
+}
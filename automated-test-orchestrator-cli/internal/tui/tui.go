@@ -0,0 +1,438 @@
+// automated-test-orchestrator-cli/internal/tui/tui.go
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/client"
+	"github.com/automated-test-orchestrator/cli-go/internal/errors"
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Options configures the dashboard.
+type Options struct {
+	Client            *client.APIClient
+	CredentialProfile string        // Used to execute tests; executing is disabled when empty
+	Interval          time.Duration // How often plans are refreshed in the background
+	ExportDir         string
+	ExportFormat      string
+}
+
+// Run starts the dashboard and blocks until the user quits.
+func Run(opts Options) error {
+	if opts.Interval <= 0 {
+		opts.Interval = 3 * time.Second
+	}
+	_, err := tea.NewProgram(newModel(opts), tea.WithAltScreen()).Run()
+	return err
+}
+
+// pane identifies one of the three panes; focus moves between them in order.
+type pane int
+
+const (
+	plansPane pane = iota
+	componentsPane
+	resultsPane
+	paneCount
+)
+
+// confirmation is an action waiting for the user to press 'y'.
+type confirmation struct {
+	prompt string
+	action tea.Cmd
+}
+
+type dashboard struct {
+	opts          Options
+	width, height int
+	focus         pane
+
+	plans      []model.CliTestPlanSummary
+	planCursor int
+	plan       *model.CliTestPlan // Details of the plan under the cursor, once loaded
+	loading    bool
+
+	compCursor    int
+	selectedComps map[string]bool // Plan component IDs marked for execution
+
+	resultCursor int
+	expanded     map[string]bool // Result IDs whose test cases are shown
+
+	status    string
+	statusErr bool
+	confirm   *confirmation
+	showHelp  bool
+	refreshed time.Time
+}
+
+func newModel(opts Options) *dashboard {
+	return &dashboard{
+		opts:          opts,
+		selectedComps: make(map[string]bool),
+		expanded:      make(map[string]bool),
+		status:        "Loading plans...",
+	}
+}
+
+func (m *dashboard) Init() tea.Cmd {
+	return tea.Batch(loadPlans(m.opts.Client), tick(m.opts.Interval))
+}
+
+// isRunning reports whether a plan is still changing on the server.
+func isRunning(status string) bool {
+	return status == "DISCOVERING" || status == "EXECUTING"
+}
+
+func (m *dashboard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case tickMsg:
+		cmds := []tea.Cmd{tick(m.opts.Interval), loadPlans(m.opts.Client)}
+		if m.plan != nil && isRunning(m.plan.Status) {
+			cmds = append(cmds, loadPlan(m.opts.Client, m.plan.ID))
+		}
+		return m, tea.Batch(cmds...)
+
+	case plansMsg:
+		if msg.err != nil {
+			m.setError(fmt.Errorf("failed to load plans: %s", errors.FormatError(msg.err)))
+			return m, nil
+		}
+		return m, m.setPlans(msg.plans)
+
+	case planMsg:
+		if summary := m.currentSummary(); summary == nil || summary.ID != msg.id {
+			return m, nil // The cursor has moved on since this was requested.
+		}
+		m.loading = false
+		if msg.err != nil {
+			m.setError(fmt.Errorf("failed to load plan: %s", errors.FormatError(msg.err)))
+			return m, nil
+		}
+		m.plan = msg.plan
+		m.compCursor = clamp(m.compCursor, len(m.plan.PlanComponents))
+		m.resultCursor = clamp(m.resultCursor, len(m.resultRows()))
+		return m, nil
+
+	case actionMsg:
+		if msg.err != nil {
+			m.setError(msg.err)
+			return m, nil
+		}
+		m.setStatus(msg.text)
+		if msg.deleted != "" && m.plan != nil && m.plan.ID == msg.deleted {
+			m.plan = nil
+		}
+		cmds := []tea.Cmd{loadPlans(m.opts.Client)}
+		if summary := m.currentSummary(); summary != nil && msg.deleted != summary.ID {
+			cmds = append(cmds, loadPlan(m.opts.Client, summary.ID))
+		}
+		return m, tea.Batch(cmds...)
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+	return m, nil
+}
+
+// setPlans replaces the plan list, newest first, keeping the cursor on the same
+// plan. It returns a command to reload the plan's details if they are stale.
+func (m *dashboard) setPlans(plans []model.CliTestPlanSummary) tea.Cmd {
+	sort.SliceStable(plans, func(i, j int) bool { return plans[i].CreatedAt.After(plans[j].CreatedAt) })
+
+	selectedID := ""
+	if summary := m.currentSummary(); summary != nil {
+		selectedID = summary.ID
+	}
+	m.plans = plans
+	m.refreshed = time.Now()
+	if m.status == "Loading plans..." || m.status == "Refreshing..." {
+		m.setStatus(fmt.Sprintf("%d plans loaded.", len(plans)))
+	}
+
+	m.planCursor = 0
+	for i, p := range plans {
+		if p.ID == selectedID {
+			m.planCursor = i
+		}
+	}
+
+	summary := m.currentSummary()
+	switch {
+	case summary == nil:
+		m.plan = nil
+		return nil
+	case m.plan == nil || m.plan.ID != summary.ID:
+		return m.selectPlan()
+	case m.plan.Status != summary.Status && !m.loading:
+		// Picked up a status change the details don't show yet.
+		m.loading = true
+		return loadPlan(m.opts.Client, summary.ID)
+	}
+	return nil
+}
+
+// selectPlan resets the component and result panes and loads the plan under the cursor.
+func (m *dashboard) selectPlan() tea.Cmd {
+	summary := m.currentSummary()
+	if summary == nil {
+		return nil
+	}
+	if m.plan != nil && m.plan.ID == summary.ID {
+		return nil
+	}
+	m.plan = nil
+	m.loading = true
+	m.compCursor, m.resultCursor = 0, 0
+	m.selectedComps = make(map[string]bool)
+	m.expanded = make(map[string]bool)
+	return loadPlan(m.opts.Client, summary.ID)
+}
+
+func (m *dashboard) currentSummary() *model.CliTestPlanSummary {
+	if m.planCursor < 0 || m.planCursor >= len(m.plans) {
+		return nil
+	}
+	return &m.plans[m.planCursor]
+}
+
+func (m *dashboard) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	if key == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	if m.confirm != nil {
+		action := m.confirm.action
+		m.confirm = nil
+		if key == "y" || key == "Y" {
+			m.setStatus("Working...")
+			return m, action
+		}
+		m.setStatus("Cancelled.")
+		return m, nil
+	}
+	if m.showHelp {
+		m.showHelp = false
+		return m, nil
+	}
+
+	switch key {
+	case "q":
+		return m, tea.Quit
+	case "?":
+		m.showHelp = true
+	case "tab", "right", "l":
+		m.focus = (m.focus + 1) % paneCount
+	case "shift+tab", "left", "h":
+		m.focus = (m.focus + paneCount - 1) % paneCount
+	case "up", "k":
+		return m, m.moveCursor(-1)
+	case "down", "j":
+		return m, m.moveCursor(1)
+	case "pgup":
+		return m, m.moveCursor(-10)
+	case "pgdown":
+		return m, m.moveCursor(10)
+	case "r":
+		m.setStatus("Refreshing...")
+		cmds := []tea.Cmd{loadPlans(m.opts.Client)}
+		if summary := m.currentSummary(); summary != nil {
+			cmds = append(cmds, loadPlan(m.opts.Client, summary.ID))
+		}
+		return m, tea.Batch(cmds...)
+	case " ", "enter":
+		m.toggle()
+	case "x":
+		m.requestExecution(false)
+	case "f":
+		m.requestExecution(true)
+	case "e":
+		return m, m.requestExport()
+	case "d":
+		m.requestDelete()
+	}
+	return m, nil
+}
+
+// moveCursor moves the cursor of the focused pane by delta rows.
+func (m *dashboard) moveCursor(delta int) tea.Cmd {
+	switch m.focus {
+	case plansPane:
+		m.planCursor = clamp(m.planCursor+delta, len(m.plans))
+		return m.selectPlan()
+	case componentsPane:
+		if m.plan != nil {
+			m.compCursor = clamp(m.compCursor+delta, len(m.plan.PlanComponents))
+		}
+	case resultsPane:
+		m.resultCursor = clamp(m.resultCursor+delta, len(m.resultRows()))
+	}
+	return nil
+}
+
+// toggle marks a component for execution, or expands or collapses a result.
+func (m *dashboard) toggle() {
+	if m.plan == nil {
+		return
+	}
+	switch m.focus {
+	case plansPane:
+		m.focus = componentsPane
+	case componentsPane:
+		if len(m.plan.PlanComponents) == 0 {
+			return
+		}
+		id := m.plan.PlanComponents[m.compCursor].ID
+		if m.selectedComps[id] {
+			delete(m.selectedComps, id)
+		} else {
+			m.selectedComps[id] = true
+		}
+	case resultsPane:
+		rows := m.resultRows()
+		if len(rows) == 0 {
+			return
+		}
+		if id := rows[m.resultCursor].resultID; id != "" {
+			m.expanded[id] = !m.expanded[id]
+			m.resultCursor = clamp(m.resultCursor, len(m.resultRows()))
+		}
+	}
+}
+
+// requestExecution asks to run the marked components' tests (or every test),
+// or only the tests that failed last time.
+func (m *dashboard) requestExecution(failedOnly bool) {
+	if m.plan == nil {
+		return
+	}
+	if m.opts.CredentialProfile == "" {
+		m.setError(fmt.Errorf("start 'ato ui' with --creds to execute tests"))
+		return
+	}
+	if isRunning(m.plan.Status) {
+		m.setError(fmt.Errorf("plan is %s; wait for it to finish", m.plan.Status))
+		return
+	}
+
+	var tests []string
+	what := "all tests"
+	switch {
+	case failedOnly:
+		tests = failedTests(m.plan)
+		if len(tests) == 0 {
+			m.setStatus("No failed tests to rerun.")
+			return
+		}
+		what = countTests(len(tests), "failed test")
+	case len(m.selectedComps) > 0:
+		tests = m.selectedTests()
+		if len(tests) == 0 {
+			m.setError(fmt.Errorf("the marked components have no tests"))
+			return
+		}
+		what = countTests(len(tests), "test")
+	}
+
+	// Executing replaces the plan's previous results, so it is confirmed like a delete.
+	m.confirm = &confirmation{
+		prompt: fmt.Sprintf("Run %s of '%s' with profile '%s'? Previous results will be replaced. (y/n)", what, m.plan.Name, m.opts.CredentialProfile),
+		action: executePlan(m.opts.Client, m.plan.ID, tests, m.opts.CredentialProfile, what),
+	}
+}
+
+func (m *dashboard) requestExport() tea.Cmd {
+	if m.plan == nil {
+		return nil
+	}
+	if len(m.plan.EnrichedResults()) == 0 {
+		m.setError(fmt.Errorf("plan has no results to export"))
+		return nil
+	}
+	m.setStatus("Exporting...")
+	return exportPlan(m.plan, m.opts.ExportDir, m.opts.ExportFormat, m.opts.CredentialProfile)
+}
+
+func (m *dashboard) requestDelete() {
+	summary := m.currentSummary()
+	if summary == nil {
+		return
+	}
+	m.confirm = &confirmation{
+		prompt: fmt.Sprintf("Delete plan '%s' (%s) and its results? (y/n)", summary.Name, summary.ID),
+		action: deletePlan(m.opts.Client, summary.ID),
+	}
+}
+
+// selectedTests returns the IDs of the tests of every marked component.
+func (m *dashboard) selectedTests() []string {
+	seen := make(map[string]bool)
+	var tests []string
+	for _, comp := range m.plan.PlanComponents {
+		if !m.selectedComps[comp.ID] {
+			continue
+		}
+		ids := []string{comp.ComponentID} // In TEST plans the components are the tests.
+		if m.plan.PlanType != "TEST" {
+			ids = ids[:0]
+			for _, t := range comp.AvailableTests {
+				ids = append(ids, t.ID)
+			}
+		}
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				tests = append(tests, id)
+			}
+		}
+	}
+	return tests
+}
+
+// failedTests returns the IDs of the tests whose latest result was not a success.
+func failedTests(plan *model.CliTestPlan) []string {
+	seen := make(map[string]bool)
+	var tests []string
+	for _, r := range plan.EnrichedResults() {
+		if r.Status != "SUCCESS" && !seen[r.TestComponentID] {
+			seen[r.TestComponentID] = true
+			tests = append(tests, r.TestComponentID)
+		}
+	}
+	return tests
+}
+
+// countTests renders "1 test" or "3 tests".
+func countTests(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+func (m *dashboard) setStatus(text string) {
+	m.status, m.statusErr = text, false
+}
+
+func (m *dashboard) setError(err error) {
+	m.status, m.statusErr = err.Error(), true
+}
+
+// clamp keeps a cursor within a list of n rows.
+func clamp(cursor, n int) int {
+	if cursor >= n {
+		cursor = n - 1
+	}
+	if cursor < 0 {
+		cursor = 0
+	}
+	return cursor
+}
@@ -0,0 +1,359 @@
+// automated-test-orchestrator-cli/internal/tui/view.go
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/automated-test-orchestrator/cli-go/internal/mappingmatrix"
+	"github.com/automated-test-orchestrator/cli-go/internal/model"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+var (
+	green  = lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
+	red    = lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+	yellow = lipgloss.NewStyle().Foreground(lipgloss.Color("3"))
+	cyan   = lipgloss.NewStyle().Foreground(lipgloss.Color("6"))
+	faint  = lipgloss.NewStyle().Faint(true)
+	bold   = lipgloss.NewStyle().Bold(true)
+	plain  = lipgloss.NewStyle()
+
+	cursorStyle  = lipgloss.NewStyle().Reverse(true)
+	focusedColor = lipgloss.Color("6")
+	blurredColor = lipgloss.Color("8")
+)
+
+const helpText = `Keys
+
+  tab, ←/→, h/l          Move between panes
+  ↑/↓, j/k, PgUp/PgDn    Move within a pane
+  space, enter           Mark a component for execution / expand a result's test cases
+  x                      Execute the marked components' tests, or every test when none are marked
+  f                      Rerun only the tests that failed
+  e                      Export the plan's results
+  d                      Delete the plan
+  r                      Refresh now
+  ?                      Show this help
+  q, ctrl+c              Quit
+
+Plans that are discovering or executing are refreshed in the background.
+Press any key to close.`
+
+// segment is a run of text drawn in one style.
+type segment struct {
+	text  string
+	style lipgloss.Style
+}
+
+// row is one line of a pane. resultID ties result-pane rows to the result they
+// belong to, so they can be expanded and collapsed.
+type row struct {
+	segments []segment
+	resultID string
+}
+
+func line(segments ...segment) row {
+	return row{segments: segments}
+}
+
+func seg(text string, style lipgloss.Style) segment {
+	return segment{text: text, style: style}
+}
+
+func (m *dashboard) View() string {
+	if m.width == 0 {
+		return "Loading..."
+	}
+
+	title := bold.Render("Automated Test Orchestrator")
+	if !m.refreshed.IsZero() {
+		title += faint.Render(fmt.Sprintf("  refreshed %s  ·  ? for help", m.refreshed.Format("15:04:05")))
+	}
+
+	bodyHeight := m.height - 2 // Title and status lines
+	var body string
+	if m.showHelp {
+		body = box(strings.Split(helpText, "\n"), m.width-2, bodyHeight, true)
+	} else {
+		plansWidth := m.width * 28 / 100
+		compsWidth := m.width * 30 / 100
+		resultsWidth := m.width - plansWidth - compsWidth
+		body = lipgloss.JoinHorizontal(lipgloss.Top,
+			m.renderPane(plansPane, plansWidth, bodyHeight),
+			m.renderPane(componentsPane, compsWidth, bodyHeight),
+			m.renderPane(resultsPane, resultsWidth, bodyHeight),
+		)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, title, body, m.statusLine())
+}
+
+func (m *dashboard) statusLine() string {
+	switch {
+	case m.confirm != nil:
+		return yellow.Render(truncate(m.confirm.prompt, m.width))
+	case m.statusErr:
+		return red.Render(truncate("Error: "+m.status, m.width))
+	default:
+		return faint.Render(truncate(m.status, m.width))
+	}
+}
+
+// renderPane draws one pane, width and height including its border.
+func (m *dashboard) renderPane(p pane, width, height int) string {
+	var (
+		title  string
+		rows   []row
+		cursor int
+	)
+	switch p {
+	case plansPane:
+		title = fmt.Sprintf("Plans (%d)", len(m.plans))
+		rows, cursor = m.planRows(), m.planCursor
+	case componentsPane:
+		title, rows = m.componentRows()
+		cursor = m.compCursor
+	case resultsPane:
+		title = "Results"
+		rows, cursor = m.resultRows(), m.resultCursor
+	}
+
+	innerWidth, listHeight := width-2, height-3 // Borders, then the title line
+	lines := []string{bold.Render(truncate(title, innerWidth))}
+	start := scrollOffset(cursor, len(rows), listHeight)
+	for i := start; i < len(rows) && i < start+listHeight; i++ {
+		lines = append(lines, renderRow(rows[i], innerWidth, p == m.focus && i == cursor))
+	}
+	return box(lines, innerWidth, height, p == m.focus)
+}
+
+func (m *dashboard) planRows() []row {
+	var rows []row
+	for _, p := range m.plans {
+		rows = append(rows, line(
+			seg(fmt.Sprintf("%-18s ", p.Status), statusStyle(p.Status)),
+			seg(p.Name, plain),
+			seg(" "+p.CreatedAt.Local().Format("01-02 15:04"), faint),
+		))
+	}
+	return rows
+}
+
+// componentRows lists the plan's components with how many tests cover each.
+func (m *dashboard) componentRows() (string, []row) {
+	if m.plan == nil {
+		if m.loading {
+			return "Components", []row{line(seg("Loading...", faint))}
+		}
+		return "Components", nil
+	}
+
+	covered := 0
+	var rows []row
+	for _, comp := range m.plan.PlanComponents {
+		mark := "[ ] "
+		if m.selectedComps[comp.ID] {
+			mark = "[x] "
+		}
+
+		var coverage segment
+		if m.plan.PlanType == "TEST" {
+			coverage = seg("test", faint)
+			covered++
+		} else {
+			switch coverageOf(len(comp.AvailableTests)) {
+			case mappingmatrix.CoverageNone:
+				coverage = seg("no tests", red)
+			case mappingmatrix.CoverageSingle:
+				coverage = seg("1 test", yellow)
+				covered++
+			default:
+				coverage = seg(fmt.Sprintf("%d tests", len(comp.AvailableTests)), green)
+				covered++
+			}
+		}
+
+		source := ""
+		if m.plan.IsEntryPoint(comp) {
+			source = " (input)"
+		}
+		coverage.text = fmt.Sprintf("%-9s", coverage.text)
+		rows = append(rows, line(
+			seg(mark, cyan),
+			coverage,
+			seg(" "+componentName(comp), plain),
+			seg(source, faint),
+		))
+	}
+
+	title := fmt.Sprintf("Components (%d/%d covered)", covered, len(m.plan.PlanComponents))
+	if n := len(m.selectedComps); n > 0 {
+		title += fmt.Sprintf(", %d marked", n)
+	}
+	return title, rows
+}
+
+// resultRows builds the results tree: components, their results, and the test
+// cases of expanded results.
+func (m *dashboard) resultRows() []row {
+	if m.plan == nil {
+		return nil
+	}
+
+	var rows []row
+	if m.plan.FailureReason != nil && strings.HasSuffix(m.plan.Status, "_FAILED") {
+		rows = append(rows, line(seg("Plan failed: "+*m.plan.FailureReason, red)))
+	}
+	if m.plan.Status == "EXECUTING" {
+		rows = append(rows, line(seg("Execution in progress...", yellow)))
+	}
+
+	for _, comp := range m.plan.PlanComponents {
+		if len(comp.ExecutionResults) == 0 {
+			continue
+		}
+		rows = append(rows, line(seg(componentName(comp), bold)))
+
+		for _, res := range comp.ExecutionResults {
+			arrow := "  "
+			if len(res.TestCases) > 0 || res.Message != nil {
+				arrow = "▸ "
+				if m.expanded[res.ID] {
+					arrow = "▾ "
+				}
+			}
+			status := seg("PASS ", green)
+			if res.Status != "SUCCESS" {
+				status = seg("FAIL ", red)
+			}
+			rows = append(rows, row{resultID: res.ID, segments: []segment{
+				seg("  "+arrow, faint), status, seg(testName(res), plain),
+			}})
+
+			if !m.expanded[res.ID] {
+				continue
+			}
+			if res.Message != nil && *res.Message != "" {
+				rows = append(rows, row{resultID: res.ID, segments: []segment{seg("      "+*res.Message, faint)}})
+			}
+			for _, tc := range res.TestCases {
+				caseStatus := seg(tc.Status+" ", green)
+				if tc.Status != "PASSED" {
+					caseStatus = seg(tc.Status+" ", red)
+				}
+				name := tc.TestDescription
+				if tc.TestCaseID != nil && *tc.TestCaseID != "" {
+					name = *tc.TestCaseID + " " + name
+				}
+				rows = append(rows, row{resultID: res.ID, segments: []segment{seg("      ", plain), caseStatus, seg(name, plain)}})
+				if tc.Details != nil && *tc.Details != "" {
+					rows = append(rows, row{resultID: res.ID, segments: []segment{seg("        "+*tc.Details, faint)}})
+				}
+			}
+		}
+	}
+
+	if len(rows) == 0 {
+		rows = append(rows, line(seg("No results yet.", faint)))
+	}
+	return rows
+}
+
+// coverageOf categorises a test count the same way 'mappings matrix' does.
+func coverageOf(tests int) string {
+	switch tests {
+	case 0:
+		return mappingmatrix.CoverageNone
+	case 1:
+		return mappingmatrix.CoverageSingle
+	default:
+		return mappingmatrix.CoverageMultiple
+	}
+}
+
+func componentName(comp model.CliPlanComponent) string {
+	if comp.ComponentName != nil && *comp.ComponentName != "" {
+		return *comp.ComponentName
+	}
+	return comp.ComponentID
+}
+
+func testName(res model.CliTestExecutionResult) string {
+	if res.TestComponentName != nil && *res.TestComponentName != "" {
+		return *res.TestComponentName
+	}
+	return res.TestComponentID
+}
+
+func statusStyle(status string) lipgloss.Style {
+	switch {
+	case strings.HasSuffix(status, "_FAILED"):
+		return red
+	case status == "COMPLETED":
+		return green
+	default:
+		return yellow
+	}
+}
+
+// renderRow draws a row cut to width, highlighting it when it has the cursor.
+func renderRow(r row, width int, selected bool) string {
+	var b strings.Builder
+	remaining := width
+	for _, s := range r.segments {
+		if remaining <= 0 {
+			break
+		}
+		text := truncate(s.text, remaining)
+		remaining -= runewidth.StringWidth(text)
+		if selected {
+			b.WriteString(cursorStyle.Render(text))
+		} else {
+			b.WriteString(s.style.Render(text))
+		}
+	}
+	if selected && remaining > 0 {
+		b.WriteString(cursorStyle.Render(strings.Repeat(" ", remaining)))
+	}
+	return b.String()
+}
+
+// box draws lines inside a rounded border, innerWidth wide and height tall
+// including the border.
+func box(lines []string, innerWidth, height int, focused bool) string {
+	border := blurredColor
+	if focused {
+		border = focusedColor
+	}
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(border).
+		Width(innerWidth).
+		Height(height - 2).
+		MaxHeight(height).
+		Render(strings.Join(lines, "\n"))
+}
+
+// scrollOffset returns the first row to draw so the cursor stays visible.
+func scrollOffset(cursor, rows, height int) int {
+	if height <= 0 || rows <= height {
+		return 0
+	}
+	start := cursor - height/2
+	if start < 0 {
+		start = 0
+	}
+	if start > rows-height {
+		start = rows - height
+	}
+	return start
+}
+
+func truncate(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	return runewidth.Truncate(s, width, "…")
+}